@@ -0,0 +1,80 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+// Cmd is the root command for AI API key subcommands.
+var Cmd = &cobra.Command{
+	Use:   "api-key",
+	Short: "Manage AI API keys",
+}
+
+// publicScope is the scope of the AI API keys granting access to all the
+// organization deployments.
+const publicScope = "public"
+
+type APIKeyShowOutput struct {
+	ID        v3.UUID `json:"id"`
+	Name      string  `json:"name"`
+	Scope     string  `json:"scope"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+func (o *APIKeyShowOutput) ToJSON()  { output.JSON(o) }
+func (o *APIKeyShowOutput) ToText()  { output.Text(o) }
+func (o *APIKeyShowOutput) ToTable() { output.Table(o) }
+
+type APIKeyValueOutput struct {
+	ID    v3.UUID `json:"id"`
+	Name  string  `json:"name"`
+	Value string  `json:"value"`
+}
+
+func (o *APIKeyValueOutput) ToJSON()  { output.JSON(o) }
+func (o *APIKeyValueOutput) ToText()  { output.Text(o) }
+func (o *APIKeyValueOutput) ToTable() { output.Table(o) }
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// resolveScope translates a user-provided key scope into the value expected
+// by the API: either "public" or the UUID of a deployment, which can be
+// referenced by name.
+func resolveScope(ctx context.Context, client *v3.Client, scope string) (string, error) {
+	if scope == "" || scope == publicScope {
+		return publicScope, nil
+	}
+
+	list, err := client.ListDeployments(ctx)
+	if err != nil {
+		return "", err
+	}
+	entry, err := list.FindListDeploymentsResponseEntry(scope)
+	if err != nil {
+		return "", err
+	}
+
+	return entry.ID.String(), nil
+}
+
+// findAPIKey resolves an AI API key by name or ID.
+func findAPIKey(ctx context.Context, client *v3.Client, nameOrID string) (v3.ListAIAPIKeysResponseEntry, error) {
+	list, err := client.ListAIAPIKeys(ctx)
+	if err != nil {
+		return v3.ListAIAPIKeysResponseEntry{}, err
+	}
+
+	return list.FindListAIAPIKeysResponseEntry(nameOrID)
+}
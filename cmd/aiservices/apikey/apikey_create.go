@@ -0,0 +1,70 @@
+package apikey
+
+import (
+	"fmt"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+)
+
+type APIKeyCreateCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"create"`
+
+	Name  string      `cli-arg:"#" cli-usage:"NAME"`
+	Scope string      `cli-flag:"scope" cli-usage:"Key scope: \"public\" for all deployments, or a deployment ID or name"`
+	Zone  v3.ZoneName `cli-short:"z" cli-usage:"zone"`
+}
+
+func (c *APIKeyCreateCmd) CmdAliases() []string { return exocmd.GCreateAlias }
+func (c *APIKeyCreateCmd) CmdShort() string     { return "Create AI API key" }
+func (c *APIKeyCreateCmd) CmdLong() string {
+	return `This command creates an AI API key.
+Because the key value is only printed during creation (or when explicitly
+revealed), --quiet (-Q) flag is not implemented for this command.`
+}
+func (c *APIKeyCreateCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *APIKeyCreateCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	if c.Name == "" {
+		return fmt.Errorf("NAME is required")
+	}
+
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	scope, err := resolveScope(ctx, client, c.Scope)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.CreateAIAPIKey(ctx, v3.CreateAIAPIKeyRequest{
+		Name:  c.Name,
+		Scope: scope,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.OutputFunc(&APIKeyValueOutput{
+		ID:    resp.ID,
+		Name:  resp.Name,
+		Value: resp.Value,
+	}, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(Cmd, &APIKeyCreateCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+		Scope:              publicScope,
+	}))
+}
@@ -0,0 +1,47 @@
+package apikey
+
+import (
+	"testing"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/testutils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+func TestAPIKeyCreate(t *testing.T) {
+	ts := newAPIKeyTestServer(t)
+	defer ts.server.Close()
+	testutils.SetupV3Client(t, ts.server.URL)
+	ts.deployments = []v3.ListDeploymentsResponseEntry{
+		{ID: v3.UUID("44444444-4444-4444-4444-444444444444"), Name: "dep1"},
+	}
+
+	// Default scope is public
+	var o output.Outputter
+	cmd := &APIKeyCreateCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings(), Name: "k1", Scope: publicScope}
+	cmd.OutputFunc = captureOutput(&o)
+	if err := cmd.CmdRun(nil, nil); err != nil {
+		t.Fatalf("api-key create: %v", err)
+	}
+	got := o.(*APIKeyValueOutput)
+	if ts.lastCreate.Scope != "public" || got.Value != "secret-value" {
+		t.Fatalf("unexpected create: request=%+v output=%+v", ts.lastCreate, got)
+	}
+
+	// Deployment scope is resolved by name
+	cmd = &APIKeyCreateCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings(), Name: "k2", Scope: "dep1"}
+	cmd.OutputFunc = captureOutput(&o)
+	if err := cmd.CmdRun(nil, nil); err != nil {
+		t.Fatalf("api-key create with deployment scope: %v", err)
+	}
+	if ts.lastCreate.Scope != "44444444-4444-4444-4444-444444444444" {
+		t.Fatalf("expected deployment ID scope, got %q", ts.lastCreate.Scope)
+	}
+
+	// Unknown deployment
+	cmd = &APIKeyCreateCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings(), Name: "k3", Scope: "nope"}
+	if err := cmd.CmdRun(nil, nil); err == nil {
+		t.Fatal("expected error for unknown deployment scope")
+	}
+}
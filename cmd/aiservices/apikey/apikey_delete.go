@@ -0,0 +1,89 @@
+package apikey
+
+import (
+	"fmt"
+	"os"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+)
+
+type APIKeyDeleteCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"delete"`
+
+	APIKeys []string    `cli-arg:"#" cli-usage:"ID or NAME..."`
+	Force   bool        `cli-short:"f" cli-usage:"don't prompt for confirmation"`
+	Zone    v3.ZoneName `cli-short:"z" cli-usage:"zone"`
+}
+
+func (c *APIKeyDeleteCmd) CmdAliases() []string { return exocmd.GDeleteAlias }
+func (c *APIKeyDeleteCmd) CmdShort() string     { return "Delete AI API key" }
+func (c *APIKeyDeleteCmd) CmdLong() string      { return "This command deletes AI API keys by ID or name." }
+func (c *APIKeyDeleteCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *APIKeyDeleteCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	list, err := client.ListAIAPIKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	keysToDelete := []v3.UUID{}
+	for _, keyStr := range c.APIKeys {
+		entry, err := list.FindListAIAPIKeysResponseEntry(keyStr)
+		if err != nil {
+			if !c.Force {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "warning: %s not found.\n", keyStr)
+			continue
+		}
+
+		if !c.Force {
+			if !utils.AskQuestion(ctx, fmt.Sprintf("Are you sure you want to delete AI API key %q?", keyStr)) {
+				return nil
+			}
+		}
+
+		keysToDelete = append(keysToDelete, entry.ID)
+	}
+
+	var fns []func() error
+	for _, id := range keysToDelete {
+		fns = append(fns, func() error {
+			op, err := client.DeleteAIAPIKey(ctx, id)
+			if err != nil {
+				return err
+			}
+			_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+			return err
+		})
+	}
+
+	err = utils.DecorateAsyncOperations("Deleting AI API key(s)...", fns...)
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		_, _ = fmt.Fprintln(os.Stdout, "AI API key(s) deleted.")
+	}
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(Cmd, &APIKeyDeleteCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings()}))
+}
@@ -0,0 +1,64 @@
+package apikey
+
+import (
+	"testing"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/testutils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+func TestAPIKeyDelete(t *testing.T) {
+	ts := newAPIKeyTestServer(t)
+	defer ts.server.Close()
+	testutils.SetupV3Client(t, ts.server.URL)
+	ts.keys = []v3.ListAIAPIKeysResponseEntry{
+		{ID: v3.UUID("11111111-1111-1111-1111-111111111111"), Name: "k1"},
+		{ID: v3.UUID("22222222-2222-2222-2222-222222222222"), Name: "k2"},
+	}
+
+	// Not found without force
+	cmd := &APIKeyDeleteCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings(), APIKeys: []string{"not-found"}}
+	if err := cmd.CmdRun(nil, nil); err == nil {
+		t.Fatal("expected error for not found key without force")
+	}
+
+	cmd = &APIKeyDeleteCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings(), APIKeys: []string{"k1", "not-found", "22222222-2222-2222-2222-222222222222"}, Force: true}
+	if err := cmd.CmdRun(nil, nil); err != nil {
+		t.Fatalf("api-key delete: %v", err)
+	}
+	if len(ts.deleted) != 2 {
+		t.Fatalf("expected 2 deleted keys, got %v", ts.deleted)
+	}
+}
+
+func TestAPIKeyRevealRotate(t *testing.T) {
+	ts := newAPIKeyTestServer(t)
+	defer ts.server.Close()
+	testutils.SetupV3Client(t, ts.server.URL)
+	ts.keys = []v3.ListAIAPIKeysResponseEntry{
+		{ID: v3.UUID("11111111-1111-1111-1111-111111111111"), Name: "k1"},
+	}
+
+	var o output.Outputter
+	reveal := &APIKeyRevealCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings(), APIKey: "k1"}
+	reveal.OutputFunc = captureOutput(&o)
+	if err := reveal.CmdRun(nil, nil); err != nil {
+		t.Fatalf("api-key reveal: %v", err)
+	}
+	got := o.(*APIKeyValueOutput)
+	if got.Value != "revealed-value" || got.Name != "k1" {
+		t.Fatalf("unexpected reveal output: %+v", got)
+	}
+
+	rotate := &APIKeyRotateCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings(), APIKey: "k1", Force: true}
+	rotate.OutputFunc = captureOutput(&o)
+	if err := rotate.CmdRun(nil, nil); err != nil {
+		t.Fatalf("api-key rotate: %v", err)
+	}
+	got = o.(*APIKeyValueOutput)
+	if got.Value != "rotated-value" {
+		t.Fatalf("unexpected rotate output: %+v", got)
+	}
+}
@@ -0,0 +1,75 @@
+package apikey
+
+import (
+	"fmt"
+	"strings"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+)
+
+type APIKeyListItemOutput struct {
+	ID        v3.UUID `json:"id"`
+	Name      string  `json:"name"`
+	Scope     string  `json:"scope"`
+	CreatedAt string  `json:"created_at"`
+}
+
+type APIKeyListOutput []APIKeyListItemOutput
+
+func (o *APIKeyListOutput) ToJSON()  { output.JSON(o) }
+func (o *APIKeyListOutput) ToText()  { output.Text(o) }
+func (o *APIKeyListOutput) ToTable() { output.Table(o) }
+
+type APIKeyListCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"list"`
+
+	Zone v3.ZoneName `cli-short:"z" cli-usage:"zone"`
+}
+
+func (c *APIKeyListCmd) CmdAliases() []string { return exocmd.GListAlias }
+func (c *APIKeyListCmd) CmdShort() string     { return "List AI API keys" }
+func (c *APIKeyListCmd) CmdLong() string {
+	return fmt.Sprintf(`This command lists the AI API keys of the organization.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&APIKeyListItemOutput{}), ", "))
+}
+func (c *APIKeyListCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *APIKeyListCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListAIAPIKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	out := make(APIKeyListOutput, 0, len(resp.AIAPIKeys))
+	for _, k := range resp.AIAPIKeys {
+		out = append(out, APIKeyListItemOutput{
+			ID:        k.ID,
+			Name:      k.Name,
+			Scope:     k.Scope,
+			CreatedAt: formatTime(k.CreatedAT),
+		})
+	}
+
+	return c.OutputFunc(&out, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(Cmd, &APIKeyListCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings()}))
+}
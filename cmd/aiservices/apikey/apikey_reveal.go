@@ -0,0 +1,55 @@
+package apikey
+
+import (
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+)
+
+type APIKeyRevealCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"reveal"`
+
+	APIKey string      `cli-arg:"#" cli-usage:"ID or NAME"`
+	Zone   v3.ZoneName `cli-short:"z" cli-usage:"zone"`
+}
+
+func (c *APIKeyRevealCmd) CmdAliases() []string { return nil }
+func (c *APIKeyRevealCmd) CmdShort() string     { return "Reveal AI API key value" }
+func (c *APIKeyRevealCmd) CmdLong() string {
+	return "This command reveals the value of an AI API key."
+}
+func (c *APIKeyRevealCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *APIKeyRevealCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	entry, err := findAPIKey(ctx, client, c.APIKey)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.RevealAIAPIKey(ctx, entry.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.OutputFunc(&APIKeyValueOutput{
+		ID:    entry.ID,
+		Name:  entry.Name,
+		Value: resp.Value,
+	}, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(Cmd, &APIKeyRevealCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings()}))
+}
@@ -0,0 +1,67 @@
+package apikey
+
+import (
+	"fmt"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+)
+
+type APIKeyRotateCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"rotate"`
+
+	APIKey string      `cli-arg:"#" cli-usage:"ID or NAME"`
+	Force  bool        `cli-short:"f" cli-usage:"don't prompt for confirmation"`
+	Zone   v3.ZoneName `cli-short:"z" cli-usage:"zone"`
+}
+
+func (c *APIKeyRotateCmd) CmdAliases() []string { return nil }
+func (c *APIKeyRotateCmd) CmdShort() string     { return "Rotate AI API key" }
+func (c *APIKeyRotateCmd) CmdLong() string {
+	return `This command generates a new value for an AI API key, the previous value
+being invalidated. The new value is printed, --quiet (-Q) flag is not
+implemented for this command.`
+}
+func (c *APIKeyRotateCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *APIKeyRotateCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	entry, err := findAPIKey(ctx, client, c.APIKey)
+	if err != nil {
+		return err
+	}
+
+	if !c.Force {
+		if !utils.AskQuestion(ctx, fmt.Sprintf("Are you sure you want to rotate AI API key %q? The current value will stop working.", c.APIKey)) {
+			return nil
+		}
+	}
+
+	resp, err := client.RotateAIAPIKey(ctx, entry.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.OutputFunc(&APIKeyValueOutput{
+		ID:    entry.ID,
+		Name:  entry.Name,
+		Value: resp.Value,
+	}, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(Cmd, &APIKeyRotateCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings()}))
+}
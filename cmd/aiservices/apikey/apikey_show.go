@@ -0,0 +1,57 @@
+package apikey
+
+import (
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+)
+
+type APIKeyShowCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"show"`
+
+	APIKey string      `cli-arg:"#" cli-usage:"ID or NAME"`
+	Zone   v3.ZoneName `cli-short:"z" cli-usage:"zone"`
+}
+
+func (c *APIKeyShowCmd) CmdAliases() []string { return exocmd.GShowAlias }
+func (c *APIKeyShowCmd) CmdShort() string     { return "Show AI API key" }
+func (c *APIKeyShowCmd) CmdLong() string {
+	return "This command shows details of an AI API key by ID or name."
+}
+func (c *APIKeyShowCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *APIKeyShowCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	entry, err := findAPIKey(ctx, client, c.APIKey)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetAIAPIKey(ctx, entry.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.OutputFunc(&APIKeyShowOutput{
+		ID:        resp.ID,
+		Name:      resp.Name,
+		Scope:     resp.Scope,
+		CreatedAt: formatTime(resp.CreatedAT),
+		UpdatedAt: formatTime(resp.UpdatedAT),
+	}, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(Cmd, &APIKeyShowCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings()}))
+}
@@ -0,0 +1,143 @@
+package apikey
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/testutils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+// Minimal test server + helpers used across API key tests in this package.
+type apiKeyTestServer struct {
+	server      *httptest.Server
+	keys        []v3.ListAIAPIKeysResponseEntry
+	deployments []v3.ListDeploymentsResponseEntry
+	lastCreate  v3.CreateAIAPIKeyRequest
+	lastUpdate  v3.UpdateAIAPIKeyRequest
+	deleted     []string
+}
+
+func newAPIKeyTestServer(t *testing.T) *apiKeyTestServer {
+	ts := &apiKeyTestServer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ai/api-key", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			testutils.WriteJSON(t, w, http.StatusOK, v3.ListAIAPIKeysResponse{AIAPIKeys: ts.keys})
+		case http.MethodPost:
+			if err := json.NewDecoder(r.Body).Decode(&ts.lastCreate); err != nil {
+				t.Fatalf("decode create request: %v", err)
+			}
+			testutils.WriteJSON(t, w, http.StatusOK, v3.CreateAIAPIKeyResponse{
+				ID:    v3.UUID("33333333-3333-3333-3333-333333333333"),
+				Name:  ts.lastCreate.Name,
+				Scope: ts.lastCreate.Scope,
+				Value: "secret-value",
+			})
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/ai/api-key/", func(w http.ResponseWriter, r *http.Request) {
+		switch action := path.Base(r.URL.Path); {
+		case action == "reveal":
+			testutils.WriteJSON(t, w, http.StatusOK, v3.RevealAIAPIKeyResponse{Value: "revealed-value"})
+			return
+		case action == "rotate":
+			testutils.WriteJSON(t, w, http.StatusOK, v3.RotateAIAPIKeyResponse{Value: "rotated-value"})
+			return
+		}
+
+		id := path.Base(r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			for _, k := range ts.keys {
+				if string(k.ID) == id {
+					testutils.WriteJSON(t, w, http.StatusOK, v3.GetAIAPIKeyResponse{
+						ID:        k.ID,
+						Name:      k.Name,
+						Scope:     k.Scope,
+						CreatedAT: k.CreatedAT,
+						UpdatedAT: k.UpdatedAT,
+					})
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		case http.MethodPatch:
+			if err := json.NewDecoder(r.Body).Decode(&ts.lastUpdate); err != nil {
+				t.Fatalf("decode update request: %v", err)
+			}
+			testutils.WriteJSON(t, w, http.StatusOK, v3.UpdateAIAPIKeyResponse{
+				ID:    v3.UUID(id),
+				Name:  ts.lastUpdate.Name,
+				Scope: ts.lastUpdate.Scope,
+			})
+		case http.MethodDelete:
+			ts.deleted = append(ts.deleted, id)
+			testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op-api-key-delete"), State: v3.OperationStateSuccess})
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/ai/deployment", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListDeploymentsResponse{Deployments: ts.deployments})
+	})
+	mux.HandleFunc("/operation/", func(w http.ResponseWriter, r *http.Request) {
+		op := v3.Operation{ID: v3.UUID(path.Base(r.URL.Path)), State: v3.OperationStateSuccess}
+		testutils.WriteJSON(t, w, http.StatusOK, op)
+	})
+	ts.server = httptest.NewServer(mux)
+	return ts
+}
+
+// captureOutput returns an OutputFunc storing the command output in got.
+func captureOutput(got *output.Outputter) func(output.Outputter, error) error {
+	return func(o output.Outputter, err error) error {
+		if err != nil {
+			return err
+		}
+		*got = o
+		return nil
+	}
+}
+
+func TestAPIKeyShow(t *testing.T) {
+	ts := newAPIKeyTestServer(t)
+	defer ts.server.Close()
+	testutils.SetupV3Client(t, ts.server.URL)
+	now := time.Now()
+	ts.keys = []v3.ListAIAPIKeysResponseEntry{{
+		ID:        v3.UUID("11111111-1111-1111-1111-111111111111"),
+		Name:      "k1",
+		Scope:     "public",
+		CreatedAT: now,
+		UpdatedAT: now,
+	}}
+
+	for _, ref := range []string{"11111111-1111-1111-1111-111111111111", "k1"} {
+		var o output.Outputter
+		cmd := &APIKeyShowCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings(), APIKey: ref}
+		cmd.OutputFunc = captureOutput(&o)
+		if err := cmd.CmdRun(nil, nil); err != nil {
+			t.Fatalf("api-key show %q: %v", ref, err)
+		}
+		got := o.(*APIKeyShowOutput)
+		if got.Name != "k1" || got.Scope != "public" || got.CreatedAt != now.Format(time.RFC3339) {
+			t.Fatalf("unexpected api-key show output: %+v", got)
+		}
+	}
+
+	cmd := &APIKeyShowCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings(), APIKey: "missing"}
+	if err := cmd.CmdRun(nil, nil); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
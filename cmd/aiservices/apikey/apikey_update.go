@@ -0,0 +1,77 @@
+package apikey
+
+import (
+	"fmt"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+)
+
+type APIKeyUpdateCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"update"`
+
+	APIKey string `cli-arg:"#" cli-usage:"ID or NAME"`
+
+	Name  string      `cli-flag:"name" cli-usage:"New AI API key name"`
+	Scope string      `cli-flag:"scope" cli-usage:"New key scope: \"public\" for all deployments, or a deployment ID or name"`
+	Zone  v3.ZoneName `cli-short:"z" cli-usage:"zone"`
+}
+
+func (c *APIKeyUpdateCmd) CmdAliases() []string { return nil }
+func (c *APIKeyUpdateCmd) CmdShort() string     { return "Update AI API key" }
+func (c *APIKeyUpdateCmd) CmdLong() string {
+	return "This command updates the name or the scope of an AI API key."
+}
+func (c *APIKeyUpdateCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *APIKeyUpdateCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	if c.Name == "" && c.Scope == "" {
+		return fmt.Errorf("nothing to update, specify --name and/or --scope")
+	}
+
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	entry, err := findAPIKey(ctx, client, c.APIKey)
+	if err != nil {
+		return err
+	}
+
+	req := v3.UpdateAIAPIKeyRequest{Name: c.Name}
+	if c.Scope != "" {
+		if req.Scope, err = resolveScope(ctx, client, c.Scope); err != nil {
+			return err
+		}
+	}
+
+	resp, err := client.UpdateAIAPIKey(ctx, entry.ID, req)
+	if err != nil {
+		return err
+	}
+
+	if globalstate.Quiet {
+		return nil
+	}
+
+	return c.OutputFunc(&APIKeyShowOutput{
+		ID:        resp.ID,
+		Name:      resp.Name,
+		Scope:     resp.Scope,
+		CreatedAt: formatTime(resp.CreatedAT),
+		UpdatedAt: formatTime(resp.UpdatedAT),
+	}, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(Cmd, &APIKeyUpdateCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings()}))
+}
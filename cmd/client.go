@@ -8,6 +8,7 @@ import (
 
 	"github.com/exoscale/cli/pkg/account"
 	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
 	v3 "github.com/exoscale/egoscale/v3"
 	"github.com/exoscale/egoscale/v3/credentials"
 )
@@ -26,8 +27,7 @@ func buildClient() {
 		account.CurrentAccount.APISecret(),
 	)
 
-	clientV3, err := v3.NewClient(
-		creds,
+	opts := []v3.ClientOpt{
 		v3.ClientOptWithRequestInterceptors(func(ctx context.Context, req *http.Request) error {
 			for k, v := range account.CurrentAccount.CustomHeaders {
 				req.Header.Add(k, v)
@@ -36,7 +36,12 @@ func buildClient() {
 			return nil
 		}),
 		v3.ClientOptWithUserAgent(fmt.Sprintf("exocli/%s/%s", globalstate.GitVersion, globalstate.GitCommit)),
-	)
+	}
+	if globalstate.Async {
+		opts = append(opts, v3.ClientOptWithHTTPClient(utils.NewAsyncHTTPClient()))
+	}
+
+	clientV3, err := v3.NewClient(creds, opts...)
 	if err != nil {
 		panic(fmt.Sprintf("unable to initialize Exoscale API V3 client: %v", err))
 	}
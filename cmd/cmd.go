@@ -211,6 +211,8 @@ func MustCLICommandFlagName(c cliCommand, field interface{}) string {
 //   - cli-hidden:"": mark the corresponding flag "hidden"
 //   - cli-deprecated:"<deprecation message>": mark the corresponding flag as hidden
 //     and display the deprecation message when used.
+//   - cli-complete:"<completer>": complete the flag values in the shell using
+//     the named Completer (see RegisterCompleter), e.g. "instance" or "zone".
 func cliCommandFlagSet(c cliCommand) (*pflag.FlagSet, error) {
 	fs := pflag.NewFlagSet("", pflag.ExitOnError)
 	cv := reflect.ValueOf(c)
@@ -325,6 +327,8 @@ func cliCommandFlagSet(c cliCommand) (*pflag.FlagSet, error) {
 //     struct field is a []string, the result is a variadic (i.e. 0 or more)
 //     list of remaining arguments; if "cli-arg:"?"` is specified, the list
 //     will be marked as optional in the "use" command help.
+//   - cli-complete:"<completer>": complete the argument in the shell using the
+//     named Completer (see RegisterCompleter).
 func cliCommandUse(c cliCommand) (string, error) {
 	var (
 		commandName string
@@ -593,6 +597,10 @@ func RegisterCLICommand(parent *cobra.Command, c cliCommand) error {
 		})
 	}
 
+	if err := cliCommandCompletion(c, cmd); err != nil {
+		return fmt.Errorf("error initializing CLI command: %s", err)
+	}
+
 	parent.AddCommand(cmd)
 
 	return nil
@@ -603,8 +611,8 @@ func init() {
 		Use:   "output",
 		Short: "Output formatting usage",
 		Long: `The exo CLI tool allows you to customize its commands output using different
-formats such as table, JSON or text template using the "--output-format" flag
-("-O" in short version).
+formats such as table, JSON, YAML, CSV or text template using the
+"--output-format" flag ("-O" in short version).
 
 By default the "table" format is applied, best suited for human reading. In
 case you need to process a command output with other CLI tools, for example
@@ -623,6 +631,15 @@ piped into jq):
 	  }
 	]
 
+The "yaml" format prints the same document as the "json" format in YAML, and
+the "csv" format prints the columns of the "table" format as comma-separated
+values, e.g. to be opened in a spreadsheet:
+
+	$ exo config list -O csv
+	Name,Default
+	alice,true
+	bob,false
+
 The "text" format prints a command's output in plain text according to a
 user-defined formatting template provided with the "--output-template" flag:
 
@@ -650,6 +667,34 @@ necessary to range on iterable data types. Each entry is terminated by a line
 return character.
 
 For the complete Go templating reference, see https://godoc.org/text/template
+
+The "--columns" flag selects the columns displayed in the "table", "csv" and
+"text" formats, in the order requested. Columns are identified by their JSON
+attribute names or their table header labels, and the "--no-headers" flag
+disables the header of the "table" and "csv" formats:
+
+	$ exo compute instance list --columns name,zone,state --no-headers -O csv
+	web-1,ch-gva-2,running
+	web-2,de-fra-1,stopped
+
+The "--sort-by" flag sorts the entries of "list" commands by a field, in
+ascending order or in descending order using the FIELD:desc form:
+
+	$ exo compute instance list --sort-by name:desc
+
+The "--query" flag filters the output with a JMESPath expression evaluated
+against its JSON form (i.e. using the attribute names of the "json" format),
+before rendering the result in the output format requested:
+
+	$ exo compute instance list --query "[?state=='running'].name" -O text
+	web-1
+	web-2
+
+Since query results are not typed, the "table", "csv" and "text" formats render
+the attributes of the objects of a query result in the order of the original
+output, and the "json" and "yaml" formats sort them alphabetically.
+
+For the complete JMESPath reference, see https://jmespath.org/specification.html
 `,
 	},
 	)
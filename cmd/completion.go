@@ -0,0 +1,336 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/iancoleman/strcase"
+	"github.com/spf13/cobra"
+
+	"github.com/exoscale/cli/pkg/account"
+	"github.com/exoscale/cli/pkg/globalstate"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+// completionCacheTTL is the duration during which the values returned by a
+// Completer are cached on disk, so that repeated completions don't require
+// API calls.
+const completionCacheTTL = 2 * time.Minute
+
+// completionTimeout is the maximum duration of the API calls performed to
+// complete a value.
+const completionTimeout = 5 * time.Second
+
+// Completer returns the candidate values for the shell completion of a type
+// of resource in the zone specified (ignored for global resources).
+type Completer func(ctx context.Context, client *v3.Client, zone v3.ZoneName) ([]string, error)
+
+var completers = map[string]Completer{
+	"instance":        completeInstances,
+	"instance-type":   completeInstanceTypes,
+	"private-network": completePrivateNetworks,
+	"security-group":  completeSecurityGroups,
+	"zone":            completeZones,
+}
+
+// RegisterCompleter registers a Completer under the name to be referenced
+// in `cli-complete` struct tags.
+func RegisterCompleter(name string, c Completer) {
+	completers[name] = c
+}
+
+func completeInstances(ctx context.Context, client *v3.Client, _ v3.ZoneName) ([]string, error) {
+	resp, err := client.ListInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, len(resp.Instances))
+	for _, instance := range resp.Instances {
+		values = append(values, instance.Name)
+	}
+
+	return values, nil
+}
+
+func completeInstanceTypes(ctx context.Context, client *v3.Client, _ v3.ZoneName) ([]string, error) {
+	resp, err := client.ListInstanceTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, len(resp.InstanceTypes))
+	for _, instanceType := range resp.InstanceTypes {
+		if instanceType.Authorized != nil && !*instanceType.Authorized {
+			continue
+		}
+		values = append(values, fmt.Sprintf("%s.%s", instanceType.Family, instanceType.Size))
+	}
+
+	return values, nil
+}
+
+func completePrivateNetworks(ctx context.Context, client *v3.Client, _ v3.ZoneName) ([]string, error) {
+	resp, err := client.ListPrivateNetworks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, len(resp.PrivateNetworks))
+	for _, privateNetwork := range resp.PrivateNetworks {
+		values = append(values, privateNetwork.Name)
+	}
+
+	return values, nil
+}
+
+func completeSecurityGroups(ctx context.Context, client *v3.Client, _ v3.ZoneName) ([]string, error) {
+	resp, err := client.ListSecurityGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, len(resp.SecurityGroups))
+	for _, securityGroup := range resp.SecurityGroups {
+		values = append(values, securityGroup.Name)
+	}
+
+	return values, nil
+}
+
+func completeZones(ctx context.Context, client *v3.Client, _ v3.ZoneName) ([]string, error) {
+	resp, err := client.ListZones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, len(resp.Zones))
+	for _, zone := range resp.Zones {
+		values = append(values, string(zone.Name))
+	}
+
+	return values, nil
+}
+
+// completionValues returns the candidate values of a type of resource, from
+// the on-disk cache if fresh enough or from the API otherwise.
+func completionValues(cmd *cobra.Command, kind string) []string {
+	complete, ok := completers[kind]
+	if !ok || globalstate.EgoscaleV3Client == nil || account.CurrentAccount == nil {
+		return nil
+	}
+
+	zone := v3.ZoneName(account.CurrentAccount.DefaultZone)
+	if flag := cmd.Flags().Lookup("zone"); flag != nil && flag.Value.String() != "" {
+		zone = v3.ZoneName(flag.Value.String())
+	}
+
+	cachePath := completionCachePath(kind, zone)
+	if values, ok := readCompletionCache(cachePath); ok {
+		return values
+	}
+
+	ctx := GContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, completionTimeout)
+	defer cancel()
+
+	client := globalstate.EgoscaleV3Client
+	if kind != "zone" {
+		var err error
+		if client, err = SwitchClientZoneV3(ctx, client, zone); err != nil {
+			return nil
+		}
+	}
+
+	values, err := complete(ctx, client, zone)
+	if err != nil {
+		return nil
+	}
+	writeCompletionCache(cachePath, values)
+
+	return values
+}
+
+// completionCachePath returns the path of the file caching the candidate
+// values of a type of resource for the current account, or an empty string
+// if the cache directory cannot be determined.
+func completionCachePath(kind string, zone v3.ZoneName) string {
+	dir, err := os.UserCacheDir()
+	if err != nil || account.CurrentAccount == nil {
+		return ""
+	}
+
+	name := fmt.Sprintf("%s_%s_%s.json", account.CurrentAccount.Name, kind, zone)
+	return filepath.Join(dir, "exoscale", "completion", url.PathEscape(name))
+}
+
+func readCompletionCache(path string) ([]string, bool) {
+	if path == "" {
+		return nil, false
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > completionCacheTTL {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	var values []string
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, false
+	}
+
+	return values, true
+}
+
+// writeCompletionCache caches the candidate values of a completion on a
+// best-effort basis: failing to do so only makes the next completion slower.
+func writeCompletionCache(path string, values []string) {
+	if path == "" {
+		return
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+	_ = os.WriteFile(path, data, 0o600)
+}
+
+// filterCompletionValues returns the values starting with the string to
+// complete. If multiple is true, the string to complete is a comma-separated
+// list of values of which only the last one is completed.
+func filterCompletionValues(values, exclude []string, toComplete string, multiple bool) []string {
+	prefix := ""
+	if i := strings.LastIndex(toComplete, ","); multiple && i >= 0 {
+		prefix, toComplete = toComplete[:i+1], toComplete[i+1:]
+		exclude = append(exclude, strings.Split(prefix, ",")...)
+	}
+
+	excluded := make(map[string]bool, len(exclude))
+	for _, v := range exclude {
+		excluded[v] = true
+	}
+
+	candidates := make([]string, 0, len(values))
+	for _, v := range values {
+		if strings.HasPrefix(v, toComplete) && !excluded[v] {
+			candidates = append(candidates, prefix+v)
+		}
+	}
+
+	return candidates
+}
+
+// cliCommandCompletion registers the shell completion functions of a
+// cobra.Command generated from the specified cliCommand struct tags. Positional
+// arguments and flags having a `cli-complete:"<type>"` tag are completed with
+// the values returned by the Completer registered under that name, and flags
+// named "zone" are completed with zone names by default.
+func cliCommandCompletion(c cliCommand, cmd *cobra.Command) error {
+	var (
+		args     []string // completer name per positional argument
+		variadic bool     // whether the last positional argument is a list
+	)
+
+	cv := reflect.ValueOf(c)
+	if cv.Kind() == reflect.Pointer {
+		cv = cv.Elem()
+	}
+
+	for i := 0; i < cv.NumField(); i++ {
+		cTypeField := cv.Type().Field(i)
+
+		if v, ok := cTypeField.Tag.Lookup("cli"); ok && v == "-" {
+			continue
+		}
+		if _, ok := cTypeField.Tag.Lookup("cli-cmd"); ok {
+			continue
+		}
+
+		kind, hasCompleter := cTypeField.Tag.Lookup("cli-complete")
+		if hasCompleter {
+			if _, ok := completers[kind]; !ok {
+				return cliCommandImplemError{fmt.Sprintf(
+					"unknown completer %q for field %s.%s", kind, cv.Type(), cTypeField.Name,
+				)}
+			}
+		}
+		multiple := cTypeField.Type.Kind() == reflect.Slice
+
+		if _, ok := cTypeField.Tag.Lookup("cli-arg"); ok {
+			args = append(args, kind)
+			variadic = multiple
+			continue
+		}
+
+		flagName := strcase.ToKebab(cTypeField.Name)
+		if v, ok := cTypeField.Tag.Lookup("cli-flag"); ok {
+			flagName = v
+		}
+		if !hasCompleter && flagName == "zone" {
+			kind, hasCompleter = "zone", true
+		}
+		if !hasCompleter {
+			continue
+		}
+
+		if err := cmd.RegisterFlagCompletionFunc(flagName,
+			func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+				values := completionValues(cmd, kind)
+				return filterCompletionValues(values, nil, toComplete, multiple), cobra.ShellCompDirectiveNoFileComp
+			}); err != nil {
+			return cliCommandImplemError{fmt.Sprintf("unable to register completion for flag --%s: %s", flagName, err)}
+		}
+	}
+
+	hasArgCompleter := false
+	for _, kind := range args {
+		hasArgCompleter = hasArgCompleter || kind != ""
+	}
+	if !hasArgCompleter {
+		return nil
+	}
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, given []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		pos := len(given)
+		if pos >= len(args) {
+			if !variadic {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			pos = len(args) - 1
+		}
+		if args[pos] == "" {
+			return nil, cobra.ShellCompDirectiveDefault
+		}
+
+		// Values already given for a list argument are not suggested again.
+		var exclude []string
+		if variadic && pos == len(args)-1 {
+			exclude = given[pos:]
+		}
+
+		values := completionValues(cmd, args[pos])
+		return filterCompletionValues(values, exclude, toComplete, false), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return nil
+}
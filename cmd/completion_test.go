@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/exoscale/cli/pkg/account"
+	"github.com/exoscale/cli/pkg/globalstate"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type testCompletionCmd struct {
+	_ bool `cli-cmd:"complete"`
+
+	Instance       string   `cli-arg:"#" cli-complete:"instance"`
+	SecurityGroups []string `cli-arg:"*" cli-complete:"security-group"`
+
+	InstanceType    string   `cli-complete:"instance-type"`
+	PrivateNetworks []string `cli-flag:"private-network" cli-complete:"private-network"`
+	Zone            string   `cli-short:"z"`
+
+	testCLICmd `cli:"-"`
+}
+
+// withCompletionCache sets up a current account, a client and an empty
+// completion cache, which tests fill so that completions don't require API
+// calls.
+func withCompletionCache(t *testing.T) {
+	t.Helper()
+
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	prevAccount, prevClient := account.CurrentAccount, globalstate.EgoscaleV3Client
+	account.CurrentAccount = &account.Account{Name: "test", DefaultZone: "ch-gva-2"}
+	globalstate.EgoscaleV3Client = &v3.Client{}
+	t.Cleanup(func() {
+		account.CurrentAccount, globalstate.EgoscaleV3Client = prevAccount, prevClient
+	})
+}
+
+func testComplete(t *testing.T, args ...string) []string {
+	t.Helper()
+
+	root := &cobra.Command{Use: "exo"}
+	require.NoError(t, RegisterCLICommand(root, &testCompletionCmd{}))
+
+	var buf bytes.Buffer
+	root.SetOut(&buf)
+	root.SetErr(&bytes.Buffer{})
+	root.SetArgs(append([]string{cobra.ShellCompRequestCmd, "complete"}, args...))
+	require.NoError(t, root.Execute())
+
+	// The last line of the output is the completion directive.
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, ":4", lines[len(lines)-1])
+
+	return lines[:len(lines)-1]
+}
+
+func Test_cliCommandCompletion(t *testing.T) {
+	withCompletionCache(t)
+	for kind, values := range map[string][]string{
+		"instance":        {"web1", "web2", "db1"},
+		"instance-type":   {"standard.small", "standard.medium", "gpu.small"},
+		"private-network": {"lan", "backend"},
+		"security-group":  {"default", "web", "ssh"},
+	} {
+		writeCompletionCache(completionCachePath(kind, "ch-gva-2"), values)
+	}
+	writeCompletionCache(completionCachePath("instance", "de-fra-1"), []string{"fra1"})
+
+	require.Equal(t, []string{"web1", "web2"}, testComplete(t, "we"))
+	require.Equal(t, []string{"default", "ssh"}, testComplete(t, "web1", "web", ""))
+	require.Equal(t, []string{"standard.small", "standard.medium"}, testComplete(t, "--instance-type", "standard."))
+	require.Equal(t, []string{"lan,backend"}, testComplete(t, "--private-network", "lan,"))
+	require.Equal(t, []string{"fra1"}, testComplete(t, "--zone", "de-fra-1", ""))
+}
+
+func Test_cliCommandCompletion_unknownCompleter(t *testing.T) {
+	cmd := &struct {
+		_        bool   `cli-cmd:"test"`
+		Instance string `cli-arg:"#" cli-complete:"unknown"`
+
+		testCLICmd `cli:"-"`
+	}{}
+
+	err := RegisterCLICommand(&cobra.Command{}, cmd)
+	require.ErrorContains(t, err, `unknown completer "unknown"`)
+}
+
+func Test_readCompletionCache(t *testing.T) {
+	withCompletionCache(t)
+	path := completionCachePath("instance", "ch-gva-2")
+
+	_, ok := readCompletionCache(path)
+	require.False(t, ok)
+
+	writeCompletionCache(path, []string{"a", "b"})
+	values, ok := readCompletionCache(path)
+	require.True(t, ok)
+	require.Equal(t, []string{"a", "b"}, values)
+
+	expired := time.Now().Add(-2 * completionCacheTTL)
+	require.NoError(t, os.Chtimes(path, expired, expired))
+	_, ok = readCompletionCache(path)
+	require.False(t, ok)
+}
@@ -10,16 +10,18 @@ import (
 	exocmd "github.com/exoscale/cli/cmd"
 	"github.com/exoscale/cli/pkg/globalstate"
 	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/selector"
 	"github.com/exoscale/cli/utils"
 	v3 "github.com/exoscale/egoscale/v3"
 )
 
 type blockStorageListItemOutput struct {
-	ID    v3.UUID                    `json:"id"`
-	Name  string                     `json:"name"`
-	Zone  v3.ZoneName                `json:"zone"`
-	Size  string                     `json:"size"`
-	State v3.BlockStorageVolumeState `json:"state"`
+	ID     v3.UUID                    `json:"id"`
+	Name   string                     `json:"name"`
+	Zone   v3.ZoneName                `json:"zone"`
+	Size   string                     `json:"size"`
+	State  v3.BlockStorageVolumeState `json:"state"`
+	Labels map[string]string          `json:"labels,omitempty" output:"-"`
 }
 
 type blockStorageListOutput []blockStorageListItemOutput
@@ -33,7 +35,8 @@ type blockStorageListCmd struct {
 
 	_ bool `cli-cmd:"list"`
 
-	Zone v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Zone     v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Selector string      `cli-short:"l" cli-usage:"label selector to filter results with, e.g. \"env=prod,tier in (web,api)\""`
 }
 
 func (c *blockStorageListCmd) CmdAliases() []string { return exocmd.GListAlias }
@@ -52,6 +55,11 @@ func (c *blockStorageListCmd) CmdPreRun(cmd *cobra.Command, args []string) error
 }
 
 func (c *blockStorageListCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	sel, err := selector.Parse(c.Selector)
+	if err != nil {
+		return err
+	}
+
 	client := globalstate.EgoscaleV3Client
 	ctx := exocmd.GContext
 
@@ -77,12 +85,16 @@ func (c *blockStorageListCmd) CmdRun(_ *cobra.Command, _ []string) error {
 		}
 
 		for _, volume := range resp.BlockStorageVolumes {
+			if !sel.Matches(volume.Labels) {
+				continue
+			}
 			output = append(output, blockStorageListItemOutput{
-				ID:    volume.ID,
-				Name:  volume.Name,
-				Zone:  zone.Name,
-				Size:  fmt.Sprintf("%d GiB", volume.Size),
-				State: volume.State,
+				ID:     volume.ID,
+				Name:   volume.Name,
+				Zone:   zone.Name,
+				Size:   fmt.Sprintf("%d GiB", volume.Size),
+				State:  volume.State,
+				Labels: volume.Labels,
 			})
 		}
 	}
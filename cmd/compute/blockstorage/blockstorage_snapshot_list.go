@@ -9,14 +9,16 @@ import (
 	exocmd "github.com/exoscale/cli/cmd"
 	"github.com/exoscale/cli/pkg/globalstate"
 	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/selector"
 	v3 "github.com/exoscale/egoscale/v3"
 )
 
 type blockStorageSnapshotListItemOutput struct {
-	ID     v3.UUID     `json:"id"`
-	Name   string      `json:"name"`
-	Zone   v3.ZoneName `json:"zone"`
-	Volume v3.UUID     `json:"volume"`
+	ID     v3.UUID           `json:"id"`
+	Name   string            `json:"name"`
+	Zone   v3.ZoneName       `json:"zone"`
+	Volume v3.UUID           `json:"volume"`
+	Labels map[string]string `json:"labels,omitempty" output:"-"`
 }
 
 type blockStorageSnapshotListOutput []blockStorageSnapshotListItemOutput
@@ -30,7 +32,8 @@ type blockStorageSnapshotListCmd struct {
 
 	_ bool `cli-cmd:"list"`
 
-	Zone v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Zone     v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Selector string      `cli-short:"l" cli-usage:"label selector to filter results with, e.g. \"env=prod,tier in (web,api)\""`
 }
 
 func (c *blockStorageSnapshotListCmd) CmdAliases() []string { return exocmd.GListAlias }
@@ -49,6 +52,11 @@ func (c *blockStorageSnapshotListCmd) CmdPreRun(cmd *cobra.Command, args []strin
 }
 
 func (c *blockStorageSnapshotListCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	sel, err := selector.Parse(c.Selector)
+	if err != nil {
+		return err
+	}
+
 	client := globalstate.EgoscaleV3Client
 	ctx := exocmd.GContext
 
@@ -82,11 +90,15 @@ func (c *blockStorageSnapshotListCmd) CmdRun(_ *cobra.Command, _ []string) error
 		}
 
 		for _, volume := range resp.BlockStorageSnapshots {
+			if !sel.Matches(volume.Labels) {
+				continue
+			}
 			output = append(output, blockStorageSnapshotListItemOutput{
 				ID:     volume.ID,
 				Name:   volume.Name,
 				Zone:   zone.Name,
 				Volume: volume.BlockStorageVolume.ID,
+				Labels: volume.Labels,
 			})
 		}
 	}
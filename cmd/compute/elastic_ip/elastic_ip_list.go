@@ -12,16 +12,18 @@ import (
 	exocmd "github.com/exoscale/cli/cmd"
 	"github.com/exoscale/cli/pkg/globalstate"
 	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/selector"
 	"github.com/exoscale/cli/utils"
 	v3 "github.com/exoscale/egoscale/v3"
 )
 
 type elasticIPListItemOutput struct {
-	ID          v3.UUID     `json:"id" outputWidth:"36"`
-	IPAddress   string      `json:"ip_address" outputWidth:"18"`
-	Description string      `json:"description" outputWidth:"70"`
-	Type        string      `json:"type" outputWidth:"10"`
-	Zone        v3.ZoneName `json:"zone" outputWidth:"8"`
+	ID          v3.UUID           `json:"id" outputWidth:"36"`
+	IPAddress   string            `json:"ip_address" outputWidth:"18"`
+	Description string            `json:"description" outputWidth:"70"`
+	Type        string            `json:"type" outputWidth:"10"`
+	Zone        v3.ZoneName       `json:"zone" outputWidth:"8"`
+	Labels      map[string]string `json:"labels,omitempty" output:"-" outputWidth:"40"`
 }
 
 type elasticIPListCmd struct {
@@ -29,7 +31,8 @@ type elasticIPListCmd struct {
 
 	_ bool `cli-cmd:"list"`
 
-	Zone v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Zone     v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Selector string      `cli-short:"l" cli-usage:"label selector to filter results with, e.g. \"env=prod,tier in (web,api)\""`
 }
 
 func (c *elasticIPListCmd) CmdAliases() []string { return exocmd.GListAlias }
@@ -52,6 +55,11 @@ func (c *elasticIPListCmd) CmdRun(_ *cobra.Command, _ []string) error {
 }
 
 func runElasticIPList(c *elasticIPListCmd, stdout, stderr io.Writer) error {
+	sel, err := selector.Parse(c.Selector)
+	if err != nil {
+		return err
+	}
+
 	client := globalstate.EgoscaleV3Client
 	ctx := exocmd.GContext
 
@@ -79,6 +87,9 @@ func runElasticIPList(c *elasticIPListCmd, stdout, stderr io.Writer) error {
 			}
 			if list != nil {
 				for _, e := range list.ElasticIPS {
+					if !sel.Matches(e.Labels) {
+						continue
+					}
 					eipType := "Manual"
 					if e.Healthcheck != nil {
 						eipType = "Managed"
@@ -89,6 +100,7 @@ func runElasticIPList(c *elasticIPListCmd, stdout, stderr io.Writer) error {
 						Description: e.Description,
 						Type:        eipType,
 						Zone:        zone.Name,
+						Labels:      e.Labels,
 					}); err != nil {
 						return err
 					}
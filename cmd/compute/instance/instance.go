@@ -3,8 +3,10 @@ package instance
 import (
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/exoscale/cli/cmd/compute"
+	"github.com/exoscale/cli/pkg/selector"
 	v3 "github.com/exoscale/egoscale/v3"
 	"github.com/spf13/cobra"
 )
@@ -58,3 +60,72 @@ func findInstance(resp *v3.ListInstancesResponse, nameOrID, zone string) (v3.Lis
 	}
 	return instance, nil
 }
+
+// findInstances looks up the instances designated by name or ID, followed by
+// the ones having labels matching a selector, from a ListInstancesResponse.
+// An instance designated several times is only returned once. If
+// ignoreMissing is true, instances not found are reported as warnings
+// instead of errors.
+func findInstances(
+	resp *v3.ListInstancesResponse,
+	namesOrIDs []string,
+	labelSelector string,
+	zone string,
+	ignoreMissing bool,
+) ([]v3.ListInstancesResponseInstances, error) {
+	if len(namesOrIDs) == 0 && labelSelector == "" {
+		return nil, errors.New("no instance specified, provide instance names or IDs, or a label selector (--selector)")
+	}
+
+	sel, err := selector.Parse(labelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make([]v3.ListInstancesResponseInstances, 0)
+	seen := make(map[v3.UUID]bool)
+	for _, nameOrID := range namesOrIDs {
+		instance, err := findInstance(resp, nameOrID, zone)
+		if err != nil {
+			if !ignoreMissing {
+				return nil, err
+			}
+			fmt.Fprintf(os.Stderr, "warning: %s not found.\n", nameOrID)
+			continue
+		}
+
+		if !seen[instance.ID] {
+			seen[instance.ID] = true
+			instances = append(instances, instance)
+		}
+	}
+
+	if !sel.Empty() {
+		matched := false
+		for _, instance := range resp.Instances {
+			if !sel.Matches(instance.Labels) {
+				continue
+			}
+			matched = true
+			if !seen[instance.ID] {
+				seen[instance.ID] = true
+				instances = append(instances, instance)
+			}
+		}
+		if !matched {
+			fmt.Fprintf(os.Stderr, "warning: no instance matching %q in zone %s.\n", labelSelector, zone)
+		}
+	}
+
+	return instances, nil
+}
+
+// instanceNames returns the names of a list of instances.
+func instanceNames(instances []v3.ListInstancesResponseInstances) []string {
+	names := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		names = append(names, instance.Name)
+	}
+
+	return names
+}
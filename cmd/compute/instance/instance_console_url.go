@@ -27,7 +27,7 @@ type instanceConsoleURLCmd struct {
 
 	_ bool `cli-cmd:"console-url"`
 
-	Instance string `cli-arg:"#" cli-usage:"NAME|ID"`
+	Instance string `cli-arg:"#" cli-complete:"instance" cli-usage:"NAME|ID"`
 
 	Zone v3.ZoneName `cli-short:"z" cli-usage:"instance zone"`
 }
@@ -40,14 +40,14 @@ type instanceCreateCmd struct {
 	DiskSize              int64             `cli-usage:"instance disk size"`
 	TPM                   bool              `cli-flag:"tpm" cli-usage:"enable TPM on instance"`
 	SecureBoot            bool              `cli-flag:"secureboot" cli-usage:"enable Secure boot on instance"`
-	InstanceType          string            `cli-usage:"instance type (format: [FAMILY.]SIZE)"`
+	InstanceType          string            `cli-complete:"instance-type" cli-usage:"instance type (format: [FAMILY.]SIZE)"`
 	Labels                map[string]string `cli-flag:"label" cli-usage:"instance label (format: key=value)"`
-	PrivateNetworks       []string          `cli-flag:"private-network" cli-usage:"instance Private Network NAME|ID (can be specified multiple times)"`
+	PrivateNetworks       []string          `cli-flag:"private-network" cli-complete:"private-network" cli-usage:"instance Private Network NAME|ID (can be specified multiple times)"`
 	PublicIPAssignment    string            `cli-flag:"public-ip" cli-usage:"Configures public IP assignment of the Instances (none|inet4|dual). (default: inet4)"`
 	ReverseDNS            string            `cli-usage:"Reverse DNS Domain"`
 	SSHKeys               []string          `cli-flag:"ssh-key" cli-usage:"SSH key to deploy on the instance (can be specified multiple times)"`
 	Protection            bool              `cli-flag:"protection" cli-usage:"enable delete protection"`
-	SecurityGroups        []string          `cli-flag:"security-group" cli-usage:"instance Security Group NAME|ID (can be specified multiple times)"`
+	SecurityGroups        []string          `cli-flag:"security-group" cli-complete:"security-group" cli-usage:"instance Security Group NAME|ID (can be specified multiple times)"`
 	Template              string            `cli-usage:"instance template NAME|ID"`
 	TemplateVisibility    string            `cli-usage:"instance template visibility (public|private)"`
 	Zone                  v3.ZoneName       `cli-short:"z" cli-usage:"instance zone"`
@@ -19,17 +19,21 @@ type instanceDeleteCmd struct {
 
 	_ bool `cli-cmd:"delete"`
 
-	Instances []string `cli-arg:"#" cli-usage:"NAME|ID"`
+	Instances []string `cli-arg:"?" cli-complete:"instance" cli-usage:"NAME|ID"`
 
-	Force bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
-	Zone  string `cli-short:"z" cli-usage:"instance zone"`
+	Force    bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
+	Selector string `cli-short:"l" cli-usage:"label selector of the instances to delete, e.g. \"env=dev\""`
+	Zone     string `cli-short:"z" cli-usage:"instance zone"`
 }
 
 func (c *instanceDeleteCmd) CmdAliases() []string { return exocmd.GRemoveAlias }
 
-func (c *instanceDeleteCmd) CmdShort() string { return "Delete a Compute instance" }
+func (c *instanceDeleteCmd) CmdShort() string { return "Delete Compute instances" }
 
-func (c *instanceDeleteCmd) CmdLong() string { return "" }
+func (c *instanceDeleteCmd) CmdLong() string {
+	return `This command deletes Compute instances, designated by name or ID and/or
+by a label selector (e.g. "--selector env=dev").`
+}
 
 func (c *instanceDeleteCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
 	exocmd.CmdSetZoneFlagFromDefault(cmd)
@@ -52,31 +56,29 @@ func (c *instanceDeleteCmd) CmdRun(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
-	instanceToDelete := []v3.UUID{}
-	for _, i := range c.Instances {
-		instance, err := findInstance(instances, i, c.Zone)
-		if err != nil {
-			if !c.Force {
-				return err
-			}
-			fmt.Fprintf(os.Stderr, "warning: %s not found.\n", i)
+	instancesToDelete, err := findInstances(instances, c.Instances, c.Selector, c.Zone, c.Force)
+	if err != nil {
+		return err
+	}
+	if len(instancesToDelete) == 0 {
+		return nil
+	}
+	names := instanceNames(instancesToDelete)
 
-			continue
+	if !c.Force {
+		question := fmt.Sprintf("Are you sure you want to delete instance %q?", names[0])
+		if len(names) > 1 {
+			question = fmt.Sprintf("Are you sure you want to delete %d instances (%s)?", len(names), strings.Join(names, ", "))
 		}
-
-		if !c.Force {
-			if !utils.AskQuestion(ctx, fmt.Sprintf("Are you sure you want to delete instance %q?", i)) {
-				return nil
-			}
+		if !utils.AskQuestion(ctx, question) {
+			return nil
 		}
-
-		instanceToDelete = append(instanceToDelete, instance.ID)
 	}
 
 	var fns []func() error
-	for _, i := range instanceToDelete {
+	for _, i := range instancesToDelete {
 		fns = append(fns, func() error {
-			op, err := client.DeleteInstance(ctx, i)
+			op, err := client.DeleteInstance(ctx, i.ID)
 			if err != nil {
 				return err
 			}
@@ -85,15 +87,15 @@ func (c *instanceDeleteCmd) CmdRun(_ *cobra.Command, _ []string) error {
 		})
 	}
 
-	err = utils.DecorateAsyncOperations(fmt.Sprintf("Deleting instance %q...", strings.Join(c.Instances, ", ")), fns...)
+	err = utils.DecorateAsyncOperations(fmt.Sprintf("Deleting instance %q...", strings.Join(names, ", ")), fns...)
 	if err != nil {
 		return err
 	}
 
 	// Cleaning up resources created in create instance
 	// https://github.com/exoscale/cli/blob/master/cmd/instance_create.go#L220
-	for _, i := range instanceToDelete {
-		instanceDir := path.Join(globalstate.ConfigFolder, "instances", i.String())
+	for _, i := range instancesToDelete {
+		instanceDir := path.Join(globalstate.ConfigFolder, "instances", i.ID.String())
 		if _, err := os.Stat(instanceDir); !os.IsNotExist(err) {
 			if err := os.RemoveAll(instanceDir); err != nil {
 				return fmt.Errorf("error deleting instance directory: %w", err)
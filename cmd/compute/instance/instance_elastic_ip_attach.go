@@ -18,7 +18,7 @@ type instanceEIPAttachCmd struct {
 
 	_ bool `cli-cmd:"attach"`
 
-	Instance  string `cli-arg:"#" cli-usage:"INSTANCE-NAME|ID"`
+	Instance  string `cli-arg:"#" cli-complete:"instance" cli-usage:"INSTANCE-NAME|ID"`
 	ElasticIP string `cli-arg:"#" cli-usage:"ELASTIC-IP-ADDRESS|ID"`
 
 	Zone string `cli-short:"z" cli-usage:"instance zone"`
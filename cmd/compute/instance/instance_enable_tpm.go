@@ -16,7 +16,7 @@ type instanceEnableTPMCmd struct {
 
 	_ bool `cli-cmd:"enable-tpm"`
 
-	Instance string `cli-arg:"#" cli-usage:"NAME|ID"`
+	Instance string `cli-arg:"#" cli-complete:"instance" cli-usage:"NAME|ID"`
 
 	Force bool        `cli-short:"f" cli-usage:"don't prompt for confirmation"`
 	Zone  v3.ZoneName `cli-short:"z" cli-usage:"instance zone"`
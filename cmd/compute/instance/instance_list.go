@@ -11,19 +11,21 @@ import (
 	exocmd "github.com/exoscale/cli/cmd"
 	"github.com/exoscale/cli/pkg/globalstate"
 	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/selector"
 	"github.com/exoscale/cli/table"
 	"github.com/exoscale/cli/utils"
 	v3 "github.com/exoscale/egoscale/v3"
 )
 
 type instanceListItemOutput struct {
-	ID          v3.UUID     `json:"id"`
-	Name        string      `json:"name"`
-	Zone        v3.ZoneName `json:"zone"`
-	Type        string      `json:"type"`
-	IPAddress   string      `json:"ip_address"`
-	IPv6Address string      `json:"ipv6_address"`
-	State       string      `json:"state"`
+	ID          v3.UUID           `json:"id"`
+	Name        string            `json:"name"`
+	Zone        v3.ZoneName       `json:"zone"`
+	Type        string            `json:"type"`
+	IPAddress   string            `json:"ip_address"`
+	IPv6Address string            `json:"ipv6_address"`
+	State       string            `json:"state"`
+	Labels      map[string]string `json:"labels,omitempty" output:"-"`
 }
 
 type instanceListOutput []instanceListItemOutput
@@ -62,7 +64,8 @@ type instanceListCmd struct {
 
 	_ bool `cli-cmd:"list"`
 
-	Zone v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Zone     v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Selector string      `cli-short:"l" cli-usage:"label selector to filter results with, e.g. \"env=prod,tier in (web,api)\""`
 }
 
 func (c *instanceListCmd) CmdAliases() []string { return exocmd.GListAlias }
@@ -81,6 +84,11 @@ func (c *instanceListCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
 }
 
 func (c *instanceListCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	sel, err := selector.Parse(c.Selector)
+	if err != nil {
+		return err
+	}
+
 	client := globalstate.EgoscaleV3Client
 	ctx := exocmd.GContext
 
@@ -111,6 +119,10 @@ func (c *instanceListCmd) CmdRun(_ *cobra.Command, _ []string) error {
 		}
 
 		for _, i := range instances.Instances {
+			if !sel.Matches(i.Labels) {
+				continue
+			}
+
 			var instanceType *v3.InstanceType
 			instanceTypeI, cached := instanceTypes.Load(i.InstanceType.ID)
 			if cached {
@@ -134,6 +146,7 @@ func (c *instanceListCmd) CmdRun(_ *cobra.Command, _ []string) error {
 				IPAddress:   utils.DefaultIP(&i.PublicIP, utils.EmptyIPAddressVisualization),
 				IPv6Address: utils.DefaultIP(i.Ipv6Address, utils.EmptyIPAddressVisualization),
 				State:       string(i.State),
+				Labels:      i.Labels,
 			}
 		}
 
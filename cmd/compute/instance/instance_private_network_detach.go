@@ -18,8 +18,8 @@ type instancePrivnetDetachCmd struct {
 
 	_ bool `cli-cmd:"detach"`
 
-	Instance       string `cli-arg:"#" cli-usage:"INSTANCE-NAME|ID"`
-	PrivateNetwork string `cli-arg:"#" cli-usage:"PRIVATE-NETWORK-NAME|ID"`
+	Instance       string `cli-arg:"#" cli-complete:"instance" cli-usage:"INSTANCE-NAME|ID"`
+	PrivateNetwork string `cli-arg:"#" cli-complete:"private-network" cli-usage:"PRIVATE-NETWORK-NAME|ID"`
 
 	Zone string `cli-short:"z" cli-usage:"instance zone"`
 }
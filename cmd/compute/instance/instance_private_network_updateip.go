@@ -19,8 +19,8 @@ type instancePrivnetUpdateIPCmd struct {
 
 	_ bool `cli-cmd:"update-ip"`
 
-	Instance       string `cli-arg:"#" cli-usage:"INSTANCE-NAME|ID"`
-	PrivateNetwork string `cli-arg:"#" cli-usage:"PRIVATE-NETWORK-NAME|ID"`
+	Instance       string `cli-arg:"#" cli-complete:"instance" cli-usage:"INSTANCE-NAME|ID"`
+	PrivateNetwork string `cli-arg:"#" cli-complete:"private-network" cli-usage:"PRIVATE-NETWORK-NAME|ID"`
 	IPAddress      string `cli-flag:"ip" cli-usage:"network IP address to assign to the Compute instance"`
 
 	Zone string `cli-short:"z" cli-usage:"instance zone"`
@@ -16,7 +16,7 @@ type instanceRebootCmd struct {
 
 	_ bool `cli-cmd:"reboot"`
 
-	Instance string `cli-arg:"#" cli-usage:"NAME|ID"`
+	Instance string `cli-arg:"#" cli-complete:"instance" cli-usage:"NAME|ID"`
 
 	Force bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
 	Zone  string `cli-short:"z" cli-usage:"instance zone"`
@@ -18,7 +18,7 @@ type instanceResetCmd struct {
 
 	_ bool `cli-cmd:"reset"`
 
-	Instance string `cli-arg:"#" cli-usage:"NAME|ID"`
+	Instance string `cli-arg:"#" cli-complete:"instance" cli-usage:"NAME|ID"`
 
 	Force              bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
 	DiskSize           int64  `cli-usage:"disk size to reset the instance to (default: current instance disk size)"`
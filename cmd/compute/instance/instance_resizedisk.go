@@ -18,7 +18,7 @@ type instanceResizeDiskCmd struct {
 
 	_ bool `cli-cmd:"resize-disk"`
 
-	Instance string `cli-arg:"#" cli-usage:"NAME|ID"`
+	Instance string `cli-arg:"#" cli-complete:"instance" cli-usage:"NAME|ID"`
 	Size     int64  `cli-arg:"#"`
 
 	Force bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
@@ -14,7 +14,7 @@ type instanceRevealCmd struct {
 
 	_ bool `cli-cmd:"reveal-password"`
 
-	Instance string `cli-arg:"#" cli-usage:"NAME|ID"`
+	Instance string `cli-arg:"#" cli-complete:"instance" cli-usage:"NAME|ID"`
 	Zone     string `cli-short:"z" cli-usage:"instance zone"`
 }
 
@@ -18,8 +18,8 @@ type instanceScaleCmd struct {
 
 	_ bool `cli-cmd:"scale"`
 
-	Instance string `cli-arg:"#" cli-usage:"NAME|ID"`
-	Type     string `cli-arg:"#" cli-usage:"SIZE"`
+	Instance string `cli-arg:"#" cli-complete:"instance" cli-usage:"NAME|ID"`
+	Type     string `cli-arg:"#" cli-complete:"instance-type" cli-usage:"SIZE"`
 
 	Force bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
 	Zone  string `cli-short:"z" cli-usage:"instance zone"`
@@ -24,7 +24,7 @@ type instanceSCPCmd struct {
 	} `cli-cmd:"-"`
 	_ bool `cli-cmd:"scp"`
 
-	Instance string `cli-arg:"#" cli-usage:"INSTANCE-NAME|ID"`
+	Instance string `cli-arg:"#" cli-complete:"instance" cli-usage:"INSTANCE-NAME|ID"`
 	Source   string `cli-arg:"#"`
 	Target   string `cli-arg:"#"`
 
@@ -18,8 +18,8 @@ type instanceSGAddCmd struct {
 
 	_ bool `cli-cmd:"add"`
 
-	Instance       string   `cli-arg:"#" cli-usage:"INSTANCE-NAME|ID"`
-	SecurityGroups []string `cli-arg:"*" cli-usage:"SECURITY-GROUP-NAME|ID"`
+	Instance       string   `cli-arg:"#" cli-complete:"instance" cli-usage:"INSTANCE-NAME|ID"`
+	SecurityGroups []string `cli-arg:"*" cli-complete:"security-group" cli-usage:"SECURITY-GROUP-NAME|ID"`
 
 	Zone string `cli-short:"z" cli-usage:"instance zone"`
 }
@@ -53,7 +53,7 @@ type instanceShowCmd struct {
 
 	_ bool `cli-cmd:"show"`
 
-	Instance string `cli-arg:"#" cli-usage:"NAME|ID"`
+	Instance string `cli-arg:"#" cli-complete:"instance" cli-usage:"NAME|ID"`
 
 	ShowUserData bool        `cli-flag:"user-data" cli-short:"u" cli-usage:"show instance cloud-init user data configuration"`
 	Zone         v3.ZoneName `cli-short:"z" cli-usage:"instance zone"`
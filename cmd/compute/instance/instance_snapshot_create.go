@@ -20,7 +20,7 @@ type instanceSnapshotCreateCmd struct {
 
 	_ bool `cli-cmd:"create"`
 
-	Instance string `cli-arg:"#" cli-usage:"INSTANCE-NAME|ID"`
+	Instance string `cli-arg:"#" cli-complete:"instance" cli-usage:"INSTANCE-NAME|ID"`
 
 	Zone string `cli-short:"z" cli-usage:"instance zone"`
 }
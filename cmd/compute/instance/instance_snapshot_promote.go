@@ -0,0 +1,136 @@
+package instance
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type instanceSnapshotPromoteOutput struct {
+	TemplateID      string `json:"template_id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	Zone            string `json:"zone"`
+	DefaultUser     string `json:"default_user"`
+	SSHKeyEnabled   bool   `json:"ssh_key_enabled"`
+	PasswordEnabled bool   `json:"password_enabled"`
+}
+
+func (o *instanceSnapshotPromoteOutput) ToJSON()  { output.JSON(o) }
+func (o *instanceSnapshotPromoteOutput) ToText()  { output.Text(o) }
+func (o *instanceSnapshotPromoteOutput) ToTable() { output.Table(o) }
+
+type instanceSnapshotPromoteCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"promote"`
+
+	ID string `cli-arg:"#"`
+
+	Description     string `cli-usage:"template description"`
+	DisablePassword bool   `cli-usage:"disable password-based authentication"`
+	DisableSSHKey   bool   `cli-flag:"disable-ssh-key" cli-usage:"disable SSH key-based authentication"`
+	Name            string `cli-usage:"template name"`
+	Username        string `cli-usage:"template default username"`
+	Zone            string `cli-short:"z" cli-usage:"snapshot zone"`
+}
+
+func (c *instanceSnapshotPromoteCmd) CmdAliases() []string { return nil }
+
+func (c *instanceSnapshotPromoteCmd) CmdShort() string {
+	return "Promote a Compute instance snapshot to a template"
+}
+
+func (c *instanceSnapshotPromoteCmd) CmdLong() string {
+	return fmt.Sprintf(`This command registers a Compute instance snapshot as a private template,
+without having to export it first.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&instanceSnapshotPromoteOutput{}), ", "))
+}
+
+func (c *instanceSnapshotPromoteCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *instanceSnapshotPromoteCmd) CmdRun(cmd *cobra.Command, _ []string) error {
+	if c.Name == "" {
+		return fmt.Errorf("--name must be specified")
+	}
+
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := client.ListSnapshots(ctx)
+	if err != nil {
+		return err
+	}
+	snapshot, err := snapshots.FindSnapshot(c.ID)
+	if err != nil {
+		return err
+	}
+
+	req := v3.PromoteSnapshotToTemplateRequest{
+		Name:        c.Name,
+		Description: c.Description,
+		DefaultUser: c.Username,
+	}
+
+	if cmd.Flags().Changed(exocmd.MustCLICommandFlagName(c, &c.DisablePassword)) {
+		req.PasswordEnabled = v3.Bool(!c.DisablePassword)
+	}
+
+	if cmd.Flags().Changed(exocmd.MustCLICommandFlagName(c, &c.DisableSSHKey)) {
+		req.SSHKeyEnabled = v3.Bool(!c.DisableSSHKey)
+	}
+
+	op, err := client.PromoteSnapshotToTemplate(ctx, snapshot.ID, req)
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Promoting snapshot %s to template %q...", c.ID, c.Name), func() {
+		op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		template, err := client.GetTemplate(ctx, op.Reference.ID)
+		if err != nil {
+			return fmt.Errorf("error retrieving newly registered template: %w", err)
+		}
+
+		return c.OutputFunc(
+			&instanceSnapshotPromoteOutput{
+				TemplateID:      template.ID.String(),
+				Name:            template.Name,
+				Description:     template.Description,
+				Zone:            c.Zone,
+				DefaultUser:     template.DefaultUser,
+				SSHKeyEnabled:   utils.DefaultBool(template.SSHKeyEnabled, false),
+				PasswordEnabled: utils.DefaultBool(template.PasswordEnabled, false),
+			},
+			nil)
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(instanceSnapshotCmd, &instanceSnapshotPromoteCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -18,7 +18,7 @@ type instanceSnapshotRevertCmd struct {
 
 	_ bool `cli-cmd:"revert"`
 
-	Instance   string `cli-arg:"#" cli-usage:"INSTANCE-NAME|ID"`
+	Instance   string `cli-arg:"#" cli-complete:"instance" cli-usage:"INSTANCE-NAME|ID"`
 	SnapshotID string `cli-arg:"#"`
 
 	Force bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
@@ -25,7 +25,7 @@ type instanceSSHCmd struct {
 	} `cli-cmd:"-"`
 	_ bool `cli-cmd:"ssh"`
 
-	Instance        string `cli-arg:"#" cli-usage:"INSTANCE-NAME|ID"`
+	Instance        string `cli-arg:"#" cli-complete:"instance" cli-usage:"INSTANCE-NAME|ID"`
 	CommandArgument string `cli-arg:"?" cli-usage:"COMMAND ARGUMENT"`
 
 	IPv6        bool   `cli-flag:"ipv6" cli-short:"6" cli-help:"connect to the instance via its IPv6 address"`
@@ -2,6 +2,7 @@ package instance
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -16,18 +17,22 @@ type instanceStartCmd struct {
 
 	_ bool `cli-cmd:"start"`
 
-	Instance string `cli-arg:"#" cli-usage:"NAME|ID"`
+	Instances []string `cli-arg:"?" cli-complete:"instance" cli-usage:"NAME|ID"`
 
 	Force         bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
 	RescueProfile string `cli-usage:"rescue profile to start the instance with"`
+	Selector      string `cli-short:"l" cli-usage:"label selector of the instances to start, e.g. \"env=dev\""`
 	Zone          string `cli-short:"z" cli-usage:"instance zone"`
 }
 
 func (c *instanceStartCmd) CmdAliases() []string { return nil }
 
-func (c *instanceStartCmd) CmdShort() string { return "Start a Compute instance" }
+func (c *instanceStartCmd) CmdShort() string { return "Start Compute instances" }
 
-func (c *instanceStartCmd) CmdLong() string { return "" }
+func (c *instanceStartCmd) CmdLong() string {
+	return `This command starts Compute instances, designated by name or ID and/or
+by a label selector (e.g. "--selector env=dev").`
+}
 
 func (c *instanceStartCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
 	exocmd.CmdSetZoneFlagFromDefault(cmd)
@@ -45,13 +50,21 @@ func (c *instanceStartCmd) CmdRun(_ *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
-	instance, err := findInstance(instances, c.Instance, c.Zone)
+	instancesToStart, err := findInstances(instances, c.Instances, c.Selector, c.Zone, false)
 	if err != nil {
 		return err
 	}
+	if len(instancesToStart) == 0 {
+		return nil
+	}
+	names := instanceNames(instancesToStart)
 
 	if !c.Force {
-		if !utils.AskQuestion(ctx, fmt.Sprintf("Are you sure you want to start instance %q?", c.Instance)) {
+		question := fmt.Sprintf("Are you sure you want to start instance %q?", names[0])
+		if len(names) > 1 {
+			question = fmt.Sprintf("Are you sure you want to start %d instances (%s)?", len(names), strings.Join(names, ", "))
+		}
+		if !utils.AskQuestion(ctx, question) {
 			return nil
 		}
 	}
@@ -61,21 +74,27 @@ func (c *instanceStartCmd) CmdRun(_ *cobra.Command, _ []string) error {
 		startrequest.RescueProfile = v3.StartInstanceRequestRescueProfile(c.RescueProfile)
 	}
 
-	op, err := client.StartInstance(ctx, instance.ID, startrequest)
-	if err != nil {
-		return err
+	var fns []func() error
+	for _, i := range instancesToStart {
+		fns = append(fns, func() error {
+			op, err := client.StartInstance(ctx, i.ID, startrequest)
+			if err != nil {
+				return err
+			}
+			_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+			return err
+		})
 	}
-	utils.DecorateAsyncOperation(fmt.Sprintf("Starting instance %q...", c.Instance), func() {
-		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
-	})
+
+	err = utils.DecorateAsyncOperations(fmt.Sprintf("Starting instance %q...", strings.Join(names, ", ")), fns...)
 	if err != nil {
 		return err
 	}
 
-	if !globalstate.Quiet {
+	if !globalstate.Quiet && len(instancesToStart) == 1 {
 		return (&instanceShowCmd{
 			CliCommandSettings: c.CliCommandSettings,
-			Instance:           instance.ID.String(),
+			Instance:           instancesToStart[0].ID.String(),
 			Zone:               v3.ZoneName(c.Zone),
 		}).CmdRun(nil, nil)
 	}
@@ -2,6 +2,7 @@ package instance
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -16,17 +17,21 @@ type instanceStopCmd struct {
 
 	_ bool `cli-cmd:"stop"`
 
-	Instance string `cli-arg:"#" cli-usage:"NAME|ID"`
+	Instances []string `cli-arg:"?" cli-complete:"instance" cli-usage:"NAME|ID"`
 
-	Force bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
-	Zone  string `cli-short:"z" cli-usage:"instance zone"`
+	Force    bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
+	Selector string `cli-short:"l" cli-usage:"label selector of the instances to stop, e.g. \"env=dev\""`
+	Zone     string `cli-short:"z" cli-usage:"instance zone"`
 }
 
 func (c *instanceStopCmd) CmdAliases() []string { return nil }
 
-func (c *instanceStopCmd) CmdShort() string { return "Stop a Compute instance" }
+func (c *instanceStopCmd) CmdShort() string { return "Stop Compute instances" }
 
-func (c *instanceStopCmd) CmdLong() string { return "" }
+func (c *instanceStopCmd) CmdLong() string {
+	return `This command stops Compute instances, designated by name or ID and/or
+by a label selector (e.g. "--selector env=dev").`
+}
 
 func (c *instanceStopCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
 	exocmd.CmdSetZoneFlagFromDefault(cmd)
@@ -44,33 +49,46 @@ func (c *instanceStopCmd) CmdRun(_ *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
-	instance, err := findInstance(instances, c.Instance, c.Zone)
+	instancesToStop, err := findInstances(instances, c.Instances, c.Selector, c.Zone, false)
 	if err != nil {
 		return err
 	}
+	if len(instancesToStop) == 0 {
+		return nil
+	}
+	names := instanceNames(instancesToStop)
 
 	if !c.Force {
-		if !utils.AskQuestion(ctx, fmt.Sprintf("Are you sure you want to stop instance %q?", c.Instance)) {
+		question := fmt.Sprintf("Are you sure you want to stop instance %q?", names[0])
+		if len(names) > 1 {
+			question = fmt.Sprintf("Are you sure you want to stop %d instances (%s)?", len(names), strings.Join(names, ", "))
+		}
+		if !utils.AskQuestion(ctx, question) {
 			return nil
 		}
 	}
 
-	op, err := client.StopInstance(ctx, instance.ID)
-	if err != nil {
-		return err
+	var fns []func() error
+	for _, i := range instancesToStop {
+		fns = append(fns, func() error {
+			op, err := client.StopInstance(ctx, i.ID)
+			if err != nil {
+				return err
+			}
+			_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+			return err
+		})
 	}
 
-	utils.DecorateAsyncOperation(fmt.Sprintf("Stopping instance %q...", c.Instance), func() {
-		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
-	})
+	err = utils.DecorateAsyncOperations(fmt.Sprintf("Stopping instance %q...", strings.Join(names, ", ")), fns...)
 	if err != nil {
 		return err
 	}
 
-	if !globalstate.Quiet {
+	if !globalstate.Quiet && len(instancesToStop) == 1 {
 		return (&instanceShowCmd{
 			CliCommandSettings: c.CliCommandSettings,
-			Instance:           instance.ID.String(),
+			Instance:           instancesToStop[0].ID.String(),
 			Zone:               v3.ZoneName(c.Zone),
 		}).CmdRun(nil, nil)
 	}
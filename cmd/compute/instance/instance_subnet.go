@@ -0,0 +1,14 @@
+package instance
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var instanceSubnetCmd = &cobra.Command{
+	Use:   "subnet",
+	Short: "Manage Compute instance VPC subnets",
+}
+
+func init() {
+	instanceCmd.AddCommand(instanceSubnetCmd)
+}
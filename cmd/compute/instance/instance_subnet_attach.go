@@ -0,0 +1,117 @@
+package instance
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type instanceSubnetAttachCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"attach"`
+
+	Instance string `cli-arg:"#" cli-complete:"instance" cli-usage:"INSTANCE-NAME|ID"`
+	VPC      string `cli-arg:"#" cli-usage:"VPC-NAME|ID"`
+	Subnet   string `cli-arg:"#" cli-usage:"SUBNET-NAME|ID"`
+
+	Zone string `cli-short:"z" cli-usage:"instance zone"`
+}
+
+func (c *instanceSubnetAttachCmd) CmdAliases() []string { return nil }
+
+func (c *instanceSubnetAttachCmd) CmdShort() string {
+	return "Attach a Compute instance to a VPC subnet"
+}
+
+func (c *instanceSubnetAttachCmd) CmdLong() string {
+	return fmt.Sprintf(`This command attaches a Compute instance to a VPC subnet.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&InstanceShowOutput{}), ", "),
+	)
+}
+
+func (c *instanceSubnetAttachCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *instanceSubnetAttachCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	instances, err := client.ListInstances(ctx)
+	if err != nil {
+		return err
+	}
+	instance, err := findInstance(instances, c.Instance, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	vpcs, err := client.ListVpcs(ctx)
+	if err != nil {
+		return err
+	}
+	vpc, err := vpcs.FindListVpcEntry(c.VPC)
+	if err != nil {
+		return err
+	}
+
+	subnets, err := client.ListSubnets(ctx, vpc.ID)
+	if err != nil {
+		return err
+	}
+	subnet, err := subnets.FindListSubnetEntry(c.Subnet)
+	if err != nil {
+		return err
+	}
+
+	op, err := client.AttachInstanceToSubnet(ctx, vpc.ID, subnet.ID, v3.AttachInstanceToSubnetRequest{
+		Instance: &v3.InstanceRef{
+			ID: instance.ID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(
+		fmt.Sprintf(
+			"Attaching instance %q to subnet %q...",
+			c.Instance,
+			c.Subnet,
+		), func() {
+			_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+		})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return (&instanceShowCmd{
+			CliCommandSettings: c.CliCommandSettings,
+			Instance:           instance.ID.String(),
+			Zone:               v3.ZoneName(c.Zone),
+		}).CmdRun(nil, nil)
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(instanceSubnetCmd, &instanceSubnetAttachCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -17,7 +17,7 @@ type instancePoolEvictCmd struct {
 	_ bool `cli-cmd:"evict"`
 
 	InstancePool string   `cli-arg:"#" cli-usage:"INSTANCE-POOL-NAME|ID"`
-	Instances    []string `cli-arg:"*" cli-usage:"INSTANCE-NAME|ID"`
+	Instances    []string `cli-arg:"*" cli-complete:"instance" cli-usage:"INSTANCE-NAME|ID"`
 
 	Force bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
 	Zone  string `cli-short:"z" cli-usage:"Instance Pool zone"`
@@ -12,16 +12,18 @@ import (
 	exocmd "github.com/exoscale/cli/cmd"
 	"github.com/exoscale/cli/pkg/globalstate"
 	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/selector"
 	"github.com/exoscale/cli/utils"
 	v3 "github.com/exoscale/egoscale/v3"
 )
 
 type instancePoolListItemOutput struct {
-	ID    v3.UUID     `json:"id" outputWidth:"36"`
-	Name  string      `json:"name" outputWidth:"70"`
-	Zone  v3.ZoneName `json:"zone" outputWidth:"8"`
-	Size  int64       `json:"size" outputWidth:"4"`
-	State string      `json:"state" outputWidth:"12"`
+	ID     v3.UUID           `json:"id" outputWidth:"36"`
+	Name   string            `json:"name" outputWidth:"70"`
+	Zone   v3.ZoneName       `json:"zone" outputWidth:"8"`
+	Size   int64             `json:"size" outputWidth:"4"`
+	State  string            `json:"state" outputWidth:"12"`
+	Labels map[string]string `json:"labels,omitempty" output:"-" outputWidth:"40"`
 }
 
 type instancePoolListCmd struct {
@@ -29,7 +31,8 @@ type instancePoolListCmd struct {
 
 	_ bool `cli-cmd:"list"`
 
-	Zone v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Zone     v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Selector string      `cli-short:"l" cli-usage:"label selector to filter results with, e.g. \"env=prod,tier in (web,api)\""`
 }
 
 func (c *instancePoolListCmd) CmdAliases() []string { return exocmd.GListAlias }
@@ -52,6 +55,11 @@ func (c *instancePoolListCmd) CmdRun(_ *cobra.Command, _ []string) error {
 }
 
 func runInstancePoolList(c *instancePoolListCmd, stdout, stderr io.Writer) error {
+	sel, err := selector.Parse(c.Selector)
+	if err != nil {
+		return err
+	}
+
 	client := globalstate.EgoscaleV3Client
 	ctx := exocmd.GContext
 
@@ -78,12 +86,16 @@ func runInstancePoolList(c *instancePoolListCmd, stdout, stderr io.Writer) error
 				return fmt.Errorf("unable to list Instance Pools in zone %s: %w", zone, err)
 			}
 			for _, i := range list.InstancePools {
+				if !sel.Matches(i.Labels) {
+					continue
+				}
 				if err := streamer.Push(instancePoolListItemOutput{
-					ID:    i.ID,
-					Name:  i.Name,
-					Zone:  zone.Name,
-					Size:  i.Size,
-					State: string(i.State),
+					ID:     i.ID,
+					Name:   i.Name,
+					Zone:   zone.Name,
+					Size:   i.Size,
+					State:  string(i.State),
+					Labels: i.Labels,
 				}); err != nil {
 					return err
 				}
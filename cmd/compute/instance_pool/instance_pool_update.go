@@ -31,13 +31,13 @@ type instancePoolUpdateCmd struct {
 	ElasticIPs         []string          `cli-flag:"elastic-ip" cli-short:"e" cli-usage:"managed Compute instances Elastic IP ADDRESS|ID (can be specified multiple times)"`
 	IPv6               bool              `cli-flag:"ipv6" cli-short:"6" cli-usage:"enable IPv6 on managed Compute instances"`
 	InstancePrefix     string            `cli-usage:"string to prefix managed Compute instances names with"`
-	InstanceType       string            `cli-usage:"managed Compute instances type (format: [FAMILY.]SIZE)"`
+	InstanceType       string            `cli-complete:"instance-type" cli-usage:"managed Compute instances type (format: [FAMILY.]SIZE)"`
 	Labels             map[string]string `cli-flag:"label" cli-usage:"Instance Pool label (format: key=value)"`
 	MinAvailable       int64             `cli-usage:"Minimum number of running Instances"`
 	Name               string            `cli-short:"n" cli-usage:"Instance Pool name"`
-	PrivateNetworks    []string          `cli-flag:"private-network" cli-usage:"managed Compute instances Private Network NAME|ID (can be specified multiple times)"`
+	PrivateNetworks    []string          `cli-flag:"private-network" cli-complete:"private-network" cli-usage:"managed Compute instances Private Network NAME|ID (can be specified multiple times)"`
 	SSHKey             string            `cli-flag:"ssh-key" cli-usage:"SSH key to deploy on managed Compute instances"`
-	SecurityGroups     []string          `cli-flag:"security-group" cli-short:"s" cli-usage:"managed Compute instances Security Group NAME|ID (can be specified multiple times)"`
+	SecurityGroups     []string          `cli-flag:"security-group" cli-complete:"security-group" cli-short:"s" cli-usage:"managed Compute instances Security Group NAME|ID (can be specified multiple times)"`
 	Template           string            `cli-short:"t" cli-usage:"managed Compute instances template NAME|ID"`
 	TemplateVisibility string            `cli-usage:"instance template visibility (public|private)"`
 	Zone               v3.ZoneName       `cli-short:"z" cli-usage:"Instance Pool zone"`
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type instanceTemplateCopyItemOutput struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Zone string `json:"zone"`
+}
+
+type instanceTemplateCopyOutput []instanceTemplateCopyItemOutput
+
+func (o *instanceTemplateCopyOutput) ToJSON()  { output.JSON(o) }
+func (o *instanceTemplateCopyOutput) ToText()  { output.Text(o) }
+func (o *instanceTemplateCopyOutput) ToTable() { output.Table(o) }
+
+type instanceTemplateCopyCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"copy"`
+
+	Template string `cli-arg:"#" cli-usage:"TEMPLATE-NAME|ID"`
+
+	ToZones []string `cli-flag:"to-zone" cli-usage:"zone to copy the template to (can be repeated multiple times)"`
+	Zone    string   `cli-short:"z" cli-usage:"template zone (default: current account's default zone)"`
+}
+
+func (c *instanceTemplateCopyCmd) CmdAliases() []string { return []string{"cp"} }
+
+func (c *instanceTemplateCopyCmd) CmdShort() string {
+	return "Copy a Compute instance template to other zones"
+}
+
+func (c *instanceTemplateCopyCmd) CmdLong() string {
+	return fmt.Sprintf(`This command copies a private Compute instance template to one or more
+zones. When several zones are specified, the copies are performed in parallel.
+
+Example:
+
+    exo compute instance-template copy my-template --to-zone de-fra-1 --to-zone at-vie-1
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&instanceTemplateCopyItemOutput{}), ", "))
+}
+
+func (c *instanceTemplateCopyCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *instanceTemplateCopyCmd) CmdRun(cmd *cobra.Command, _ []string) error {
+	if len(c.ToZones) == 0 {
+		exocmd.CmdExitOnUsageError(cmd, "no target zone specified")
+	}
+
+	zones := make([]string, 0, len(c.ToZones))
+	for _, z := range c.ToZones {
+		if z == c.Zone {
+			return fmt.Errorf("template is already in zone %q", z)
+		}
+		if !slices.Contains(zones, z) {
+			zones = append(zones, z)
+		}
+	}
+
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	templates, err := client.ListTemplates(ctx, v3.ListTemplatesWithVisibility(v3.ListTemplatesVisibilityPrivate))
+	if err != nil {
+		return err
+	}
+
+	template, err := templates.FindTemplate(c.Template)
+	if err != nil {
+		return err
+	}
+
+	out := make(instanceTemplateCopyOutput, len(zones))
+	fns := make([]func() error, 0, len(zones))
+	for i, zone := range zones {
+		fns = append(fns, func() error {
+			op, err := client.CopyTemplate(ctx, template.ID, v3.CopyTemplateRequest{
+				TargetZone: &v3.Zone{Name: v3.ZoneName(zone)},
+			})
+			if err != nil {
+				return fmt.Errorf("zone %s: %w", zone, err)
+			}
+
+			op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+			if err != nil {
+				return fmt.Errorf("zone %s: %w", zone, err)
+			}
+
+			out[i] = instanceTemplateCopyItemOutput{Name: template.Name, Zone: zone}
+			if op.Reference != nil {
+				out[i].ID = op.Reference.ID.String()
+			}
+
+			return nil
+		})
+	}
+
+	err = utils.DecorateAsyncOperations(
+		fmt.Sprintf("Copying template %q to %s...", template.Name, strings.Join(zones, ", ")),
+		fns...,
+	)
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return c.OutputFunc(&out, nil)
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(instanceTemplateCmd, &instanceTemplateCopyCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/testutils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+const testTemplateID = v3.UUID("11111111-1111-1111-1111-111111111111")
+
+func TestInstanceTemplateCopy(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		copies []string
+	)
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/zone", func(w http.ResponseWriter, _ *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListZonesResponse{
+			Zones: []v3.Zone{{APIEndpoint: v3.Endpoint(server.URL), Name: "ch-gva-2"}},
+		})
+	})
+	mux.HandleFunc("/template", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "private", r.URL.Query().Get("visibility"))
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListTemplatesResponse{
+			Templates: []v3.Template{{ID: testTemplateID, Name: "golden-image"}},
+		})
+	})
+	mux.HandleFunc("/template/"+testTemplateID.String(), func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		var req v3.CopyTemplateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		mu.Lock()
+		copies = append(copies, string(req.TargetZone.Name))
+		mu.Unlock()
+
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: "op", State: v3.OperationStateSuccess})
+	})
+	mux.HandleFunc("/operation/", func(w http.ResponseWriter, _ *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: "op", State: v3.OperationStateSuccess})
+	})
+
+	testutils.SetupV3Client(t, server.URL)
+
+	cmd := &instanceTemplateCopyCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+		Template:           "golden-image",
+		ToZones:            []string{"de-fra-1", "at-vie-1", "de-fra-1"},
+		Zone:               "ch-gva-2",
+	}
+	require.NoError(t, cmd.CmdRun(nil, nil))
+
+	sort.Strings(copies)
+	assert.Equal(t, []string{"at-vie-1", "de-fra-1"}, copies)
+
+	cmd.ToZones = []string{"ch-gva-2"}
+	assert.ErrorContains(t, cmd.CmdRun(nil, nil), `already in zone "ch-gva-2"`)
+}
@@ -182,25 +182,7 @@ func (c *instanceTemplateRegisterCmd) CmdRun(cmd *cobra.Command, _ []string) err
 	}
 
 	if !globalstate.Quiet {
-		return c.OutputFunc(&instanceTemplateShowOutput{
-			ID:                           template.ID.String(),
-			Zone:                         c.Zone,
-			Family:                       template.Family,
-			Name:                         template.Name,
-			Description:                  template.Description,
-			CreationDate:                 template.CreatedAT.String(),
-			Visibility:                   string(template.Visibility),
-			Size:                         template.Size,
-			Version:                      template.Version,
-			Build:                        template.Build,
-			Maintainer:                   template.Maintainer,
-			Checksum:                     template.Checksum,
-			DefaultUser:                  template.DefaultUser,
-			SSHKeyEnabled:                utils.DefaultBool(template.SSHKeyEnabled, false),
-			PasswordEnabled:              utils.DefaultBool(template.PasswordEnabled, false),
-			BootMode:                     string(template.BootMode),
-			AppConsistentSnapshotEnabled: utils.DefaultBool(template.ApplicationConsistentSnapshotEnabled, false),
-		}, nil)
+		return c.OutputFunc(newInstanceTemplateShowOutput(template, c.Zone), nil)
 	}
 
 	return nil
@@ -108,9 +108,13 @@ func (c *instanceTemplateShowCmd) CmdRun(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
-	return c.OutputFunc(&instanceTemplateShowOutput{
+	return c.OutputFunc(newInstanceTemplateShowOutput(&template, c.Zone), nil)
+}
+
+func newInstanceTemplateShowOutput(template *v3.Template, zone string) *instanceTemplateShowOutput {
+	return &instanceTemplateShowOutput{
 		ID:                           template.ID.String(),
-		Zone:                         c.Zone,
+		Zone:                         zone,
 		Family:                       template.Family,
 		Name:                         template.Name,
 		Description:                  template.Description,
@@ -126,7 +130,7 @@ func (c *instanceTemplateShowCmd) CmdRun(_ *cobra.Command, _ []string) error {
 		PasswordEnabled:              utils.DefaultBool(template.PasswordEnabled, false),
 		BootMode:                     string(template.BootMode),
 		AppConsistentSnapshotEnabled: utils.DefaultBool(template.ApplicationConsistentSnapshotEnabled, false),
-	}, nil)
+	}
 }
 
 func init() {
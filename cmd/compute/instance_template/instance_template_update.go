@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type instanceTemplateUpdateCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"update"`
+
+	Template string `cli-arg:"#" cli-usage:"TEMPLATE-NAME|ID"`
+
+	Description string `cli-usage:"template description"`
+	Name        string `cli-usage:"template name"`
+	Zone        string `cli-short:"z" cli-usage:"template zone (default: current account's default zone)"`
+}
+
+func (c *instanceTemplateUpdateCmd) CmdAliases() []string { return nil }
+
+func (c *instanceTemplateUpdateCmd) CmdShort() string {
+	return "Update a Compute instance template"
+}
+
+func (c *instanceTemplateUpdateCmd) CmdLong() string {
+	return fmt.Sprintf(`This command updates a private Compute instance template.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&instanceTemplateShowOutput{}), ", "))
+}
+
+func (c *instanceTemplateUpdateCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *instanceTemplateUpdateCmd) CmdRun(cmd *cobra.Command, _ []string) error {
+	var (
+		updateReq v3.UpdateTemplateRequest
+		updated   bool
+	)
+
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	templates, err := client.ListTemplates(ctx, v3.ListTemplatesWithVisibility(v3.ListTemplatesVisibilityPrivate))
+	if err != nil {
+		return err
+	}
+
+	template, err := templates.FindTemplate(c.Template)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed(exocmd.MustCLICommandFlagName(c, &c.Description)) {
+		updateReq.Description = c.Description
+		updated = true
+	}
+
+	if cmd.Flags().Changed(exocmd.MustCLICommandFlagName(c, &c.Name)) {
+		updateReq.Name = c.Name
+		updated = true
+	}
+
+	if !updated {
+		return fmt.Errorf("nothing to update")
+	}
+
+	op, err := client.UpdateTemplate(ctx, template.ID, updateReq)
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Updating template %q...", template.Name), func() {
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		t, err := client.GetTemplate(ctx, template.ID)
+		if err != nil {
+			return err
+		}
+
+		return c.OutputFunc(newInstanceTemplateShowOutput(t, c.Zone), nil)
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(instanceTemplateCmd, &instanceTemplateUpdateCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -12,15 +12,17 @@ import (
 	exocmd "github.com/exoscale/cli/cmd"
 	"github.com/exoscale/cli/pkg/globalstate"
 	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/selector"
 	"github.com/exoscale/cli/utils"
 	v3 "github.com/exoscale/egoscale/v3"
 )
 
 type nlbListItemOutput struct {
-	ID        v3.UUID     `json:"id" outputWidth:"36"`
-	Name      string      `json:"name" outputWidth:"70"`
-	Zone      v3.ZoneName `json:"zone" outputWidth:"8"`
-	IPAddress string      `json:"ip_address" outputWidth:"18"`
+	ID        v3.UUID           `json:"id" outputWidth:"36"`
+	Name      string            `json:"name" outputWidth:"70"`
+	Zone      v3.ZoneName       `json:"zone" outputWidth:"8"`
+	IPAddress string            `json:"ip_address" outputWidth:"18"`
+	Labels    map[string]string `json:"labels,omitempty" output:"-" outputWidth:"40"`
 }
 
 type nlbListCmd struct {
@@ -28,7 +30,8 @@ type nlbListCmd struct {
 
 	_ bool `cli-cmd:"list"`
 
-	Zone v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Zone     v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Selector string      `cli-short:"l" cli-usage:"label selector to filter results with, e.g. \"env=prod,tier in (web,api)\""`
 }
 
 func (c *nlbListCmd) CmdAliases() []string { return exocmd.GListAlias }
@@ -51,6 +54,11 @@ func (c *nlbListCmd) CmdRun(_ *cobra.Command, _ []string) error {
 }
 
 func runNlbList(c *nlbListCmd, stdout, stderr io.Writer) error {
+	sel, err := selector.Parse(c.Selector)
+	if err != nil {
+		return err
+	}
+
 	client := globalstate.EgoscaleV3Client
 	ctx := exocmd.GContext
 
@@ -77,11 +85,15 @@ func runNlbList(c *nlbListCmd, stdout, stderr io.Writer) error {
 				return fmt.Errorf("unable to list Network Load Balancers in zone %s: %w", zone, err)
 			}
 			for _, nlb := range list.LoadBalancers {
+				if !sel.Matches(nlb.Labels) {
+					continue
+				}
 				if err := streamer.Push(nlbListItemOutput{
 					ID:        nlb.ID,
 					Name:      nlb.Name,
 					Zone:      zone.Name,
 					IPAddress: nlb.IP.String(),
+					Labels:    nlb.Labels,
 				}); err != nil {
 					return err
 				}
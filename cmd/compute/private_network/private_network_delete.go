@@ -16,7 +16,7 @@ type privateNetworkDeleteCmd struct {
 
 	_ bool `cli-cmd:"delete"`
 
-	PrivateNetwork string `cli-arg:"#" cli-usage:"NAME|ID"`
+	PrivateNetwork string `cli-arg:"#" cli-complete:"private-network" cli-usage:"NAME|ID"`
 
 	Force bool        `cli-short:"f" cli-usage:"don't prompt for confirmation"`
 	Zone  v3.ZoneName `cli-short:"z" cli-usage:"Private Network zone"`
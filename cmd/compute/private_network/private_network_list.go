@@ -12,14 +12,16 @@ import (
 	exocmd "github.com/exoscale/cli/cmd"
 	"github.com/exoscale/cli/pkg/globalstate"
 	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/selector"
 	"github.com/exoscale/cli/utils"
 	v3 "github.com/exoscale/egoscale/v3"
 )
 
 type privateNetworkListItemOutput struct {
-	ID   v3.UUID     `json:"id" outputWidth:"36"`
-	Name string      `json:"name" outputWidth:"70"`
-	Zone v3.ZoneName `json:"zone" outputWidth:"8"`
+	ID     v3.UUID           `json:"id" outputWidth:"36"`
+	Name   string            `json:"name" outputWidth:"70"`
+	Zone   v3.ZoneName       `json:"zone" outputWidth:"8"`
+	Labels map[string]string `json:"labels,omitempty" output:"-" outputWidth:"40"`
 }
 
 type privateNetworkListCmd struct {
@@ -27,7 +29,8 @@ type privateNetworkListCmd struct {
 
 	_ bool `cli-cmd:"list"`
 
-	Zone v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Zone     v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Selector string      `cli-short:"l" cli-usage:"label selector to filter results with, e.g. \"env=prod,tier in (web,api)\""`
 }
 
 func (c *privateNetworkListCmd) CmdAliases() []string { return exocmd.GListAlias }
@@ -50,6 +53,11 @@ func (c *privateNetworkListCmd) CmdRun(_ *cobra.Command, _ []string) error {
 }
 
 func runPrivateNetworkList(c *privateNetworkListCmd, stdout, stderr io.Writer) error {
+	sel, err := selector.Parse(c.Selector)
+	if err != nil {
+		return err
+	}
+
 	client := globalstate.EgoscaleV3Client
 	ctx := exocmd.GContext
 
@@ -76,10 +84,14 @@ func runPrivateNetworkList(c *privateNetworkListCmd, stdout, stderr io.Writer) e
 				return fmt.Errorf("unable to list Private Networks in zone %s: %w", zone, err)
 			}
 			for _, p := range resp.PrivateNetworks {
+				if !sel.Matches(p.Labels) {
+					continue
+				}
 				if err := streamer.Push(privateNetworkListItemOutput{
-					ID:   p.ID,
-					Name: p.Name,
-					Zone: zone.Name,
+					ID:     p.ID,
+					Name:   p.Name,
+					Zone:   zone.Name,
+					Labels: p.Labels,
 				}); err != nil {
 					return err
 				}
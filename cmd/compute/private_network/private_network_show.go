@@ -74,7 +74,7 @@ type privateNetworkShowCmd struct {
 
 	_ bool `cli-cmd:"show"`
 
-	PrivateNetwork string `cli-arg:"#" cli-usage:"NAME|ID"`
+	PrivateNetwork string `cli-arg:"#" cli-complete:"private-network" cli-usage:"NAME|ID"`
 
 	Zone v3.ZoneName `cli-short:"z" cli-usage:"Private Network zone"`
 }
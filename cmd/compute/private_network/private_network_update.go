@@ -19,7 +19,7 @@ type privateNetworkUpdateCmd struct {
 
 	_ bool `cli-cmd:"update"`
 
-	PrivateNetwork string `cli-arg:"#" cli-usage:"NAME|ID"`
+	PrivateNetwork string `cli-arg:"#" cli-complete:"private-network" cli-usage:"NAME|ID"`
 
 	Description  string      `cli-usage:"Private Network description"`
 	EndIP        string      `cli-usage:"Private Network range end IP address"`
@@ -18,7 +18,7 @@ type securityGroupDeleteCmd struct {
 	_ bool `cli-cmd:"delete"`
 
 	DeleteRules   bool   `cli-short:"r" cli-usage:"Delete all rules but not the security group"`
-	SecurityGroup string `cli-arg:"#" cli-usage:"SECURITY-GROUP-NAME|ID"`
+	SecurityGroup string `cli-arg:"#" cli-complete:"security-group" cli-usage:"SECURITY-GROUP-NAME|ID"`
 
 	Force bool `cli-short:"f" cli-usage:"don't prompt for confirmation"`
 }
@@ -33,7 +33,7 @@ type securityGroupAddRuleCmd struct {
 
 	_ bool `cli-cmd:"add"`
 
-	SecurityGroup string `cli-arg:"#" cli-usage:"SECURITY-GROUP-ID|NAME"`
+	SecurityGroup string `cli-arg:"#" cli-complete:"security-group" cli-usage:"SECURITY-GROUP-ID|NAME"`
 
 	Description               string                                        `cli-usage:"rule description"`
 	FlowDirection             v3.AddRuleToSecurityGroupRequestFlowDirection `cli-flag:"flow" cli-usage:"rule network flow direction (ingress|egress)"`
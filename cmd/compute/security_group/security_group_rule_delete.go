@@ -19,7 +19,7 @@ type securityGroupDeleteRuleCmd struct {
 
 	_ bool `cli-cmd:"delete"`
 
-	SecurityGroup string  `cli-arg:"#" cli-usage:"SECURITY-GROUP-ID|NAME"`
+	SecurityGroup string  `cli-arg:"#" cli-complete:"security-group" cli-usage:"SECURITY-GROUP-ID|NAME"`
 	Rule          v3.UUID `cli-arg:"#"`
 
 	Force bool `cli-short:"f" cli-usage:"don't prompt for confirmation"`
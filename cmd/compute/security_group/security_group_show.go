@@ -136,7 +136,7 @@ type securityGroupShowCmd struct {
 
 	_ bool `cli-cmd:"show"`
 
-	SecurityGroup string `cli-arg:"#" cli-usage:"NAME|ID"`
+	SecurityGroup string `cli-arg:"#" cli-complete:"security-group" cli-usage:"NAME|ID"`
 }
 
 func (c *securityGroupShowCmd) CmdAliases() []string { return exocmd.GShowAlias }
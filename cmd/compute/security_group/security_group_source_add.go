@@ -19,7 +19,7 @@ type securityGroupAddSourceCmd struct {
 
 	_ bool `cli-cmd:"add"`
 
-	SecurityGroup string `cli-arg:"#" cli-usage:"SECURITY-GROUP-ID|NAME"`
+	SecurityGroup string `cli-arg:"#" cli-complete:"security-group" cli-usage:"SECURITY-GROUP-ID|NAME"`
 	Cidr          string `cli-arg:"#" cli-usage:"CIDR"`
 }
 
@@ -20,7 +20,7 @@ type securityGroupRemoveSourceCmd struct {
 
 	_ bool `cli-cmd:"remove"`
 
-	SecurityGroup string `cli-arg:"#" cli-usage:"SECURITY-GROUP-ID|NAME"`
+	SecurityGroup string `cli-arg:"#" cli-complete:"security-group" cli-usage:"SECURITY-GROUP-ID|NAME"`
 	Cidr          string `cli-arg:"#" cli-usage:"CIDR"`
 
 	Force bool `cli-short:"f" cli-usage:"don't prompt for confirmation"`
@@ -52,12 +52,12 @@ type sksCreateCmd struct {
 	NodepoolImageGcHighThreshold int64             `cli-flag:"nodepool-image-gc-high-threshold" cli-usage:"default Nodepool the percent of disk usage after which image garbage collection is always run"`
 	NodepoolImageGcMinAge        string            `cli-flag:"nodepool-image-gc-min-age" cli-usage:"default Nodepool maximum age an image can be unused before it is garbage collected"`
 	NodepoolInstancePrefix       string            `cli-usage:"string to prefix default Nodepool member names with"`
-	NodepoolInstanceType         string            `cli-usage:"default Nodepool Compute instances type"`
+	NodepoolInstanceType         string            `cli-complete:"instance-type" cli-usage:"default Nodepool Compute instances type"`
 	NodepoolLabels               map[string]string `cli-flag:"nodepool-label" cli-usage:"default Nodepool label (format: key=value)"`
 	NodepoolName                 string            `cli-usage:"default Nodepool name"`
 	NodepoolNvidiaMigProfile     string            `cli-flag:"nodepool-nvidia-mig-profile" cli-usage:"default Nodepool Nvidia MIG profile to enable on the GPUs (e.g. 4g.24gb); the GPU family is inferred from the instance type"`
-	NodepoolPrivateNetworks      []string          `cli-flag:"nodepool-private-network" cli-usage:"default Nodepool Private Network NAME|ID (can be specified multiple times)"`
-	NodepoolSecurityGroups       []string          `cli-flag:"nodepool-security-group" cli-usage:"default Nodepool Security Group NAME|ID (can be specified multiple times)"`
+	NodepoolPrivateNetworks      []string          `cli-flag:"nodepool-private-network" cli-complete:"private-network" cli-usage:"default Nodepool Private Network NAME|ID (can be specified multiple times)"`
+	NodepoolSecurityGroups       []string          `cli-flag:"nodepool-security-group" cli-complete:"security-group" cli-usage:"default Nodepool Security Group NAME|ID (can be specified multiple times)"`
 	NodepoolSize                 int64             `cli-usage:"default Nodepool size. If 0, no default Nodepool will be added to the cluster."`
 	NodepoolTaints               []string          `cli-flag:"nodepool-taint" cli-usage:"Kubernetes taint to apply to default Nodepool Nodes (format: KEY=VALUE:EFFECT, can be specified multiple times)"`
 	NodePoolPublicIPAssignment   string            `cli-flag:"nodepool-public-ip" cli-usage:"Configures public IP assignment of the Instances (inet4|dual). (default: inet4)"`
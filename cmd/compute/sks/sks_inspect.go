@@ -0,0 +1,333 @@
+package sks
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+const (
+	sksInspectSeverityError   = "error"
+	sksInspectSeverityWarning = "warning"
+)
+
+// sksInspectionKeys are the inspection result keys the command knows how to
+// report on: a result having none of them can't be trusted to be healthy.
+var sksInspectionKeys = []string{"control-plane", "components", "errors", "warnings"}
+
+// sksInspectHealthyStatuses are the control plane components status not
+// considered as blocking problems.
+var sksInspectHealthyStatuses = []string{"healthy", "running"}
+
+type sksInspectComponentOutput struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type sksInspectNodepoolOutput struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+	Size  int64  `json:"size"`
+}
+
+type sksInspectFindingOutput struct {
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+type sksInspectDeprecatedResourceOutput struct {
+	Resource       string `json:"resource"`
+	RemovedRelease string `json:"removed_release"`
+	Blocking       bool   `json:"blocking"`
+}
+
+type sksInspectOutput struct {
+	ID                  string                               `json:"id"`
+	Name                string                               `json:"name"`
+	Version             string                               `json:"version"`
+	TargetVersion       string                               `json:"target_version"`
+	State               string                               `json:"state"`
+	ControlPlane        []sksInspectComponentOutput          `json:"control_plane" outputLabel:"Control Plane"`
+	Nodepools           []sksInspectNodepoolOutput           `json:"nodepools"`
+	Findings            []sksInspectFindingOutput            `json:"findings"`
+	DeprecatedResources []sksInspectDeprecatedResourceOutput `json:"deprecated_resources" outputLabel:"Deprecated Resources"`
+	BlockingProblems    int                                  `json:"blocking_problems"`
+}
+
+func (o *sksInspectOutput) ToJSON()  { output.JSON(o) }
+func (o *sksInspectOutput) ToText()  { output.Text(o) }
+func (o *sksInspectOutput) ToTable() { output.Table(o) }
+
+type sksInspectCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"inspect"`
+
+	Cluster string `cli-arg:"#" cli-usage:"NAME|ID"`
+
+	TargetVersion string `cli-usage:"Kubernetes version to check deprecated resources against (default: next minor version)"`
+	Zone          string `cli-short:"z" cli-usage:"SKS cluster zone"`
+}
+
+func (c *sksInspectCmd) CmdAliases() []string { return nil }
+
+func (c *sksInspectCmd) CmdShort() string { return "Inspect an SKS cluster health" }
+
+func (c *sksInspectCmd) CmdLong() string {
+	return fmt.Sprintf(`This command reports an SKS cluster health, based on the inspection run
+periodically by the SKS service: control plane components status, Nodepools
+state, errors and warnings, as well as the deprecated Kubernetes APIs the
+cluster resources are still using.
+
+The command exits with an error if blocking problems are found, i.e. if the
+cluster or one of its Nodepools is in an error state, if a control plane
+component is not healthy, if the inspection reports errors or has an
+unrecognised format, or if deprecated APIs are removed in the target version.
+This allows gating "exo compute sks upgrade" in scripts.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&sksInspectOutput{}), ", "))
+}
+
+func (c *sksInspectCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *sksInspectCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	clusters, err := client.ListSKSClusters(ctx)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := clusters.FindSKSCluster(c.Cluster)
+	if err != nil {
+		return err
+	}
+
+	inspection, err := client.GetSKSClusterInspection(ctx, cluster.ID)
+	if err != nil {
+		return fmt.Errorf("error retrieving cluster inspection: %w", err)
+	}
+
+	deprecatedResources, err := client.ListSKSClusterDeprecatedResources(ctx, cluster.ID)
+	if err != nil {
+		return fmt.Errorf("error retrieving deprecated resources: %w", err)
+	}
+
+	targetVersion := c.TargetVersion
+	if targetVersion == "" {
+		targetVersion = fmt.Sprintf("%d.%d", utils.VersionMajor(cluster.Version), utils.VersionMinor(cluster.Version)+1)
+	}
+
+	out := newSKSInspectOutput(&cluster, *inspection, deprecatedResources, targetVersion)
+
+	if err := c.OutputFunc(out, nil); err != nil {
+		return err
+	}
+
+	if out.BlockingProblems > 0 {
+		return fmt.Errorf("SKS cluster %q has %d blocking problem(s)", cluster.Name, out.BlockingProblems)
+	}
+
+	return nil
+}
+
+// newSKSInspectOutput builds an SKS cluster inspection report from the
+// cluster details, its inspection result and its deprecated resources.
+func newSKSInspectOutput(
+	cluster *v3.SKSCluster,
+	inspection v3.GetSKSClusterInspectionResponse,
+	deprecatedResources []v3.SKSClusterDeprecatedResource,
+	targetVersion string,
+) *sksInspectOutput {
+	out := &sksInspectOutput{
+		ID:                  cluster.ID.String(),
+		Name:                cluster.Name,
+		Version:             cluster.Version,
+		TargetVersion:       targetVersion,
+		State:               string(cluster.State),
+		ControlPlane:        sksInspectionComponents(inspection),
+		Nodepools:           make([]sksInspectNodepoolOutput, 0, len(cluster.Nodepools)),
+		Findings:            sksInspectionFindings(inspection),
+		DeprecatedResources: make([]sksInspectDeprecatedResourceOutput, 0, len(deprecatedResources)),
+	}
+
+	if !sksInspectionRecognised(inspection) {
+		keys := make([]string, 0, len(inspection))
+		for k := range inspection {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		out.Findings = append(out.Findings, sksInspectFindingOutput{
+			Severity: sksInspectSeverityError,
+			Message:  fmt.Sprintf("unrecognised inspection result (keys: %s)", strings.Join(keys, ", ")),
+		})
+	}
+
+	if cluster.State == v3.SKSClusterStateError {
+		out.BlockingProblems++
+	}
+
+	for _, c := range out.ControlPlane {
+		if !slices.Contains(sksInspectHealthyStatuses, strings.ToLower(c.Status)) {
+			out.BlockingProblems++
+		}
+	}
+
+	for _, np := range cluster.Nodepools {
+		out.Nodepools = append(out.Nodepools, sksInspectNodepoolOutput{
+			Name:  np.Name,
+			State: string(np.State),
+			Size:  np.Size,
+		})
+		if np.State == v3.SKSNodepoolStateError {
+			out.BlockingProblems++
+		}
+	}
+
+	for _, f := range out.Findings {
+		if f.Severity == sksInspectSeverityError {
+			out.BlockingProblems++
+		}
+	}
+
+	for _, r := range deprecatedResources {
+		blocking := r.RemovedRelease != "" && !sksVersionIsOlder(targetVersion, r.RemovedRelease)
+		out.DeprecatedResources = append(out.DeprecatedResources, sksInspectDeprecatedResourceOutput{
+			Resource:       formatDeprecatedResource(r),
+			RemovedRelease: r.RemovedRelease,
+			Blocking:       blocking,
+		})
+		if blocking {
+			out.BlockingProblems++
+		}
+	}
+
+	return out
+}
+
+// sksVersionIsOlder returns true if the major.minor version a is strictly
+// older than b.
+func sksVersionIsOlder(a, b string) bool {
+	if utils.VersionMajor(a) != utils.VersionMajor(b) {
+		return utils.VersionMajor(a) < utils.VersionMajor(b)
+	}
+
+	return utils.VersionMinor(a) < utils.VersionMinor(b)
+}
+
+// sksInspectionRecognised returns true if the inspection result has at least
+// one of the keys the command knows how to report on.
+func sksInspectionRecognised(inspection v3.GetSKSClusterInspectionResponse) bool {
+	for _, key := range sksInspectionKeys {
+		if _, ok := inspection[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sksInspectionComponents extracts the control plane components status from
+// an SKS cluster inspection result, expected either as a map of component
+// names to status or as a list of objects with a name and a status.
+func sksInspectionComponents(inspection v3.GetSKSClusterInspectionResponse) []sksInspectComponentOutput {
+	components := make([]sksInspectComponentOutput, 0)
+
+	for _, key := range []string{"control-plane", "components"} {
+		switch v := inspection[key].(type) {
+		case map[string]any:
+			for name, status := range v {
+				components = append(components, sksInspectComponentOutput{
+					Name:   name,
+					Status: sksInspectionStatus(status),
+				})
+			}
+		case []any:
+			for _, item := range v {
+				if m, ok := item.(map[string]any); ok {
+					components = append(components, sksInspectComponentOutput{
+						Name:   sksInspectionString(m, "name", "component"),
+						Status: sksInspectionStatus(m),
+					})
+				}
+			}
+		}
+	}
+
+	sort.Slice(components, func(i, j int) bool { return components[i].Name < components[j].Name })
+
+	return components
+}
+
+// sksInspectionFindings extracts the errors and warnings reported by an SKS
+// cluster inspection result, errors first.
+func sksInspectionFindings(inspection v3.GetSKSClusterInspectionResponse) []sksInspectFindingOutput {
+	findings := make([]sksInspectFindingOutput, 0)
+
+	for _, severity := range []string{sksInspectSeverityError, sksInspectSeverityWarning} {
+		items, ok := inspection[severity+"s"].([]any)
+		if !ok {
+			continue
+		}
+
+		for _, item := range items {
+			message := fmt.Sprint(item)
+			if m, ok := item.(map[string]any); ok {
+				message = sksInspectionString(m, "message", "description", "error", "reason")
+				if resource := sksInspectionString(m, "resource", "name"); resource != "" {
+					message = resource + ": " + message
+				}
+			}
+
+			findings = append(findings, sksInspectFindingOutput{
+				Severity: severity,
+				Message:  message,
+			})
+		}
+	}
+
+	return findings
+}
+
+func sksInspectionStatus(v any) string {
+	if m, ok := v.(map[string]any); ok {
+		return sksInspectionString(m, "status", "state", "health")
+	}
+
+	return fmt.Sprint(v)
+}
+
+// sksInspectionString returns the value of the first key found in m.
+func sksInspectionString(m map[string]any, keys ...string) string {
+	for _, k := range keys {
+		if v, ok := m[k]; ok && v != nil {
+			return fmt.Sprint(v)
+		}
+	}
+
+	return ""
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(sksCmd, &sksInspectCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,81 @@
+package sks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+func TestNewSKSInspectOutput(t *testing.T) {
+	cluster := &v3.SKSCluster{
+		ID:      v3.UUID("11111111-1111-1111-1111-111111111111"),
+		Name:    "prod",
+		Version: "1.31.2",
+		State:   v3.SKSClusterStateRunning,
+		Nodepools: []v3.SKSNodepool{
+			{Name: "workers", State: v3.SKSNodepoolStateRunning, Size: 3},
+			{Name: "gpu", State: v3.SKSNodepoolStateError, Size: 1},
+		},
+	}
+
+	inspection := v3.GetSKSClusterInspectionResponse{
+		"control-plane": map[string]any{
+			"kube-apiserver": "healthy",
+			"etcd":           map[string]any{"status": "degraded"},
+		},
+		"errors": []any{
+			map[string]any{"resource": "kube-system/coredns", "message": "no ready replica"},
+		},
+		"warnings": []any{"konnectivity agent is missing on 1 node"},
+	}
+
+	deprecated := []v3.SKSClusterDeprecatedResource{
+		{Group: "policy", Version: "v1beta1", Resource: "podsecuritypolicies", RemovedRelease: "1.32"},
+		{Group: "flowcontrol.apiserver.k8s.io", Version: "v1beta3", Resource: "flowschemas", RemovedRelease: "1.35"},
+	}
+
+	out := newSKSInspectOutput(cluster, inspection, deprecated, "1.32")
+
+	assert.Equal(t, []sksInspectComponentOutput{
+		{Name: "etcd", Status: "degraded"},
+		{Name: "kube-apiserver", Status: "healthy"},
+	}, out.ControlPlane)
+	assert.Equal(t, []sksInspectFindingOutput{
+		{Severity: "error", Message: "kube-system/coredns: no ready replica"},
+		{Severity: "warning", Message: "konnectivity agent is missing on 1 node"},
+	}, out.Findings)
+	assert.Equal(t, []sksInspectDeprecatedResourceOutput{
+		{Resource: formatDeprecatedResource(deprecated[0]), RemovedRelease: "1.32", Blocking: true},
+		{Resource: formatDeprecatedResource(deprecated[1]), RemovedRelease: "1.35", Blocking: false},
+	}, out.DeprecatedResources)
+	// degraded etcd, gpu Nodepool in error state, coredns error,
+	// podsecuritypolicies removal.
+	assert.Equal(t, 4, out.BlockingProblems)
+
+	healthy := &v3.SKSCluster{Name: "dev", Version: "1.31.2", State: v3.SKSClusterStateRunning}
+	assert.Equal(t, 0, newSKSInspectOutput(healthy, v3.GetSKSClusterInspectionResponse{
+		"control-plane": map[string]any{"kube-apiserver": "Running"},
+		"errors":        []any{},
+	}, nil, "1.32").BlockingProblems)
+}
+
+func TestNewSKSInspectOutputUnrecognised(t *testing.T) {
+	cluster := &v3.SKSCluster{Name: "dev", Version: "1.31.2", State: v3.SKSClusterStateRunning}
+
+	for name, inspection := range map[string]v3.GetSKSClusterInspectionResponse{
+		"empty":   {},
+		"unknown": {"checks": []any{map[string]any{"name": "etcd", "result": "failed"}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out := newSKSInspectOutput(cluster, inspection, nil, "1.32")
+
+			assert.Equal(t, 1, out.BlockingProblems)
+			if assert.Len(t, out.Findings, 1) {
+				assert.Equal(t, sksInspectSeverityError, out.Findings[0].Severity)
+				assert.Contains(t, out.Findings[0].Message, "unrecognised inspection result")
+			}
+		})
+	}
+}
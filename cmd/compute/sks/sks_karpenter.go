@@ -0,0 +1,123 @@
+package sks
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/exoscale/cli/pkg/globalstate"
+)
+
+var sksKarpenterCmd = &cobra.Command{
+	Use:   "karpenter",
+	Short: "Generate Karpenter manifests for an SKS cluster",
+	Long: `These commands generate the Kubernetes manifests of the Karpenter resources
+(ExoscaleNodeClass and NodePool) needed to enable autoscaling on an SKS
+cluster deployed with the Karpenter addon.`,
+}
+
+func init() {
+	sksCmd.AddCommand(sksKarpenterCmd)
+}
+
+// sksKarpenterPatchManifest applies patch to every document of a YAML
+// manifest, preserving the order of the keys.
+func sksKarpenterPatchManifest(manifest string, patch func(yaml.MapSlice) yaml.MapSlice) (string, error) {
+	var (
+		out bytes.Buffer
+		dec = yaml.NewDecoder(strings.NewReader(manifest))
+		enc = yaml.NewEncoder(&out)
+	)
+
+	for {
+		var doc yaml.MapSlice
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return "", fmt.Errorf("unable to parse manifest: %w", err)
+		}
+
+		if err := enc.Encode(patch(doc)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
+
+// sksYAMLGet returns the value located at path in a YAML document.
+func sksYAMLGet(doc yaml.MapSlice, path ...string) any {
+	for i, item := range doc {
+		if item.Key != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return doc[i].Value
+		}
+		if m, ok := item.Value.(yaml.MapSlice); ok {
+			return sksYAMLGet(m, path[1:]...)
+		}
+		return nil
+	}
+
+	return nil
+}
+
+// sksYAMLSet sets the value located at path in a YAML document, creating the
+// intermediate mappings if needed.
+func sksYAMLSet(doc yaml.MapSlice, value any, path ...string) yaml.MapSlice {
+	for i, item := range doc {
+		if item.Key != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			doc[i].Value = value
+			return doc
+		}
+		m, _ := item.Value.(yaml.MapSlice)
+		doc[i].Value = sksYAMLSet(m, value, path[1:]...)
+		return doc
+	}
+
+	if len(path) == 1 {
+		return append(doc, yaml.MapItem{Key: path[0], Value: value})
+	}
+
+	return append(doc, yaml.MapItem{Key: path[0], Value: sksYAMLSet(nil, value, path[1:]...)})
+}
+
+// sksKarpenterWriteManifest prints a manifest on the standard output, or
+// writes it to the file specified in dir if dir is not empty.
+func sksKarpenterWriteManifest(manifest, dir, file string) error {
+	if dir == "" {
+		_, err := fmt.Fprint(os.Stdout, manifest)
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, file)
+	if err := os.WriteFile(path, []byte(manifest), 0o644); err != nil {
+		return fmt.Errorf("unable to write manifest: %w", err)
+	}
+
+	if !globalstate.Quiet {
+		fmt.Fprintf(os.Stderr, "Manifest written to %s\n", path)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package sks
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type sksKarpenterNodeclassCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"nodeclass"`
+
+	Cluster string `cli-arg:"#" cli-usage:"CLUSTER-NAME|ID"`
+
+	DiskSize           int64    `cli-usage:"Compute instances disk size (in GiB)"`
+	Name               string   `cli-usage:"ExoscaleNodeClass name"`
+	OutputDir          string   `cli-usage:"write the manifest to a file in this directory instead of the standard output"`
+	PrivateNetworks    []string `cli-flag:"private-network" cli-usage:"Compute instances Private Network NAME|ID (can be specified multiple times)"`
+	SecurityGroups     []string `cli-flag:"security-group" cli-usage:"Compute instances Security Group NAME|ID (can be specified multiple times)"`
+	Template           string   `cli-usage:"Compute instances template NAME|ID"`
+	TemplateVisibility string   `cli-usage:"Compute instances template visibility (public|private)"`
+	Zone               string   `cli-short:"z" cli-usage:"SKS cluster zone"`
+}
+
+func (c *sksKarpenterNodeclassCmd) CmdAliases() []string { return nil }
+
+func (c *sksKarpenterNodeclassCmd) CmdShort() string {
+	return "Generate a Karpenter ExoscaleNodeClass manifest"
+}
+
+func (c *sksKarpenterNodeclassCmd) CmdLong() string {
+	return `This command generates the Kubernetes manifest of a Karpenter
+ExoscaleNodeClass for an SKS cluster, describing the Compute instances
+provisioned by Karpenter. The manifest can be applied using
+"kubectl apply -f".`
+}
+
+func (c *sksKarpenterNodeclassCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *sksKarpenterNodeclassCmd) CmdRun(cmd *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	clusters, err := client.ListSKSClusters(ctx)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := clusters.FindSKSCluster(c.Cluster)
+	if err != nil {
+		return err
+	}
+
+	var templateID string
+	if c.Template != "" {
+		templates, err := client.ListTemplates(ctx, v3.ListTemplatesWithVisibility(v3.ListTemplatesVisibility(c.TemplateVisibility)))
+		if err != nil {
+			return err
+		}
+		template, err := templates.FindTemplate(c.Template)
+		if err != nil {
+			return fmt.Errorf("error retrieving template: %w", err)
+		}
+		templateID = template.ID.String()
+	}
+
+	securityGroups, err := lookupSecurityGroups(ctx, client, c.SecurityGroups)
+	if err != nil {
+		return err
+	}
+
+	privateNetworks, err := lookupPrivateNetworks(ctx, client, c.PrivateNetworks)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.GenerateSKSKarpenterExoscaleNodeclass(ctx, cluster.ID)
+	if err != nil {
+		return err
+	}
+
+	diskSizeChanged := cmd.Flags().Changed(exocmd.MustCLICommandFlagName(c, &c.DiskSize))
+
+	manifest, err := sksKarpenterPatchManifest(res.ExoscaleNodeclass, func(doc yaml.MapSlice) yaml.MapSlice {
+		if c.Name != "" {
+			doc = sksYAMLSet(doc, c.Name, "metadata", "name")
+		}
+		if templateID != "" {
+			doc = sksYAMLSet(doc, templateID, "spec", "templateID")
+		}
+		if diskSizeChanged {
+			doc = sksYAMLSet(doc, c.DiskSize, "spec", "diskSize")
+		}
+		if len(securityGroups) > 0 {
+			ids := make([]string, len(securityGroups))
+			for i, sg := range securityGroups {
+				ids[i] = sg.ID.String()
+			}
+			doc = sksYAMLSet(doc, ids, "spec", "securityGroups")
+		}
+		if len(privateNetworks) > 0 {
+			ids := make([]string, len(privateNetworks))
+			for i, pn := range privateNetworks {
+				ids[i] = pn.ID.String()
+			}
+			doc = sksYAMLSet(doc, ids, "spec", "privateNetworks")
+		}
+		return doc
+	})
+	if err != nil {
+		return err
+	}
+
+	return sksKarpenterWriteManifest(manifest, c.OutputDir, "nodeclass.yaml")
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(sksKarpenterCmd, &sksKarpenterNodeclassCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+
+		TemplateVisibility: exocmd.DefaultTemplateVisibility,
+	}))
+}
@@ -0,0 +1,125 @@
+package sks
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+const sksKarpenterInstanceTypeLabel = "node.kubernetes.io/instance-type"
+
+type sksKarpenterNodepoolCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"nodepool"`
+
+	Cluster string `cli-arg:"#" cli-usage:"CLUSTER-NAME|ID"`
+
+	InstanceTypes []string `cli-flag:"instance-type" cli-usage:"Compute instance type Karpenter can provision (format: FAMILY.SIZE, can be specified multiple times)"`
+	Name          string   `cli-usage:"NodePool name"`
+	Nodeclass     string   `cli-usage:"name of the ExoscaleNodeClass referenced by the NodePool"`
+	OutputDir     string   `cli-usage:"write the manifest to a file in this directory instead of the standard output"`
+	Zone          string   `cli-short:"z" cli-usage:"SKS cluster zone"`
+}
+
+func (c *sksKarpenterNodepoolCmd) CmdAliases() []string { return nil }
+
+func (c *sksKarpenterNodepoolCmd) CmdShort() string {
+	return "Generate a Karpenter NodePool manifest"
+}
+
+func (c *sksKarpenterNodepoolCmd) CmdLong() string {
+	return `This command generates the Kubernetes manifest of a Karpenter NodePool for
+an SKS cluster, describing the constraints of the Nodes provisioned by
+Karpenter. The manifest can be applied using "kubectl apply -f".`
+}
+
+func (c *sksKarpenterNodepoolCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *sksKarpenterNodepoolCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	clusters, err := client.ListSKSClusters(ctx)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := clusters.FindSKSCluster(c.Cluster)
+	if err != nil {
+		return err
+	}
+
+	instanceTypes := make([]string, 0, len(c.InstanceTypes))
+	for _, name := range c.InstanceTypes {
+		it, err := lookupInstanceType(ctx, client, name)
+		if err != nil {
+			return err
+		}
+		instanceTypes = append(instanceTypes, fmt.Sprintf("%s.%s", it.Family, it.Size))
+	}
+
+	res, err := client.GenerateSKSKarpenterNodepool(ctx, cluster.ID)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := sksKarpenterPatchManifest(res.Nodepool, func(doc yaml.MapSlice) yaml.MapSlice {
+		if c.Name != "" {
+			doc = sksYAMLSet(doc, c.Name, "metadata", "name")
+		}
+		if c.Nodeclass != "" {
+			doc = sksYAMLSet(doc, c.Nodeclass, "spec", "template", "spec", "nodeClassRef", "name")
+		}
+		if len(instanceTypes) > 0 {
+			doc = sksYAMLSet(doc,
+				sksKarpenterSetRequirement(sksYAMLGet(doc, "spec", "template", "spec", "requirements"),
+					sksKarpenterInstanceTypeLabel, instanceTypes),
+				"spec", "template", "spec", "requirements")
+		}
+		return doc
+	})
+	if err != nil {
+		return err
+	}
+
+	return sksKarpenterWriteManifest(manifest, c.OutputDir, "nodepool.yaml")
+}
+
+// sksKarpenterSetRequirement replaces the NodePool requirement on the label
+// key specified with an "In" requirement on the values specified.
+func sksKarpenterSetRequirement(requirements any, key string, values []string) []any {
+	res := make([]any, 0)
+
+	if list, ok := requirements.([]any); ok {
+		for _, r := range list {
+			if m, ok := r.(yaml.MapSlice); ok && sksYAMLGet(m, "key") == key {
+				continue
+			}
+			res = append(res, r)
+		}
+	}
+
+	return append(res, yaml.MapSlice{
+		{Key: "key", Value: key},
+		{Key: "operator", Value: "In"},
+		{Key: "values", Value: values},
+	})
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(sksKarpenterCmd, &sksKarpenterNodepoolCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
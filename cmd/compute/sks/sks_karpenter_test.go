@@ -0,0 +1,70 @@
+package sks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+const testKarpenterNodepool = `apiVersion: karpenter.sh/v1
+kind: NodePool
+metadata:
+  name: default
+spec:
+  template:
+    spec:
+      nodeClassRef:
+        group: karpenter.exoscale.com
+        kind: ExoscaleNodeClass
+        name: default
+      requirements:
+      - key: kubernetes.io/arch
+        operator: In
+        values:
+        - amd64
+      - key: node.kubernetes.io/instance-type
+        operator: In
+        values:
+        - standard.medium
+`
+
+func TestSKSKarpenterPatchManifest(t *testing.T) {
+	manifest, err := sksKarpenterPatchManifest(testKarpenterNodepool, func(doc yaml.MapSlice) yaml.MapSlice {
+		doc = sksYAMLSet(doc, "gpu", "metadata", "name")
+		doc = sksYAMLSet(doc, "gpu-class", "spec", "template", "spec", "nodeClassRef", "name")
+		doc = sksYAMLSet(doc, "30s", "spec", "disruption", "consolidateAfter")
+		return sksYAMLSet(doc,
+			sksKarpenterSetRequirement(sksYAMLGet(doc, "spec", "template", "spec", "requirements"),
+				sksKarpenterInstanceTypeLabel, []string{"gpua5000.small", "gpua5000.medium"}),
+			"spec", "template", "spec", "requirements")
+	})
+	require.NoError(t, err)
+	require.Equal(t, `apiVersion: karpenter.sh/v1
+kind: NodePool
+metadata:
+  name: gpu
+spec:
+  template:
+    spec:
+      nodeClassRef:
+        group: karpenter.exoscale.com
+        kind: ExoscaleNodeClass
+        name: gpu-class
+      requirements:
+      - key: kubernetes.io/arch
+        operator: In
+        values:
+        - amd64
+      - key: node.kubernetes.io/instance-type
+        operator: In
+        values:
+        - gpua5000.small
+        - gpua5000.medium
+  disruption:
+    consolidateAfter: 30s
+`, manifest)
+
+	_, err = sksKarpenterPatchManifest("key: [", func(doc yaml.MapSlice) yaml.MapSlice { return doc })
+	require.Error(t, err)
+}
@@ -12,15 +12,17 @@ import (
 	exocmd "github.com/exoscale/cli/cmd"
 	"github.com/exoscale/cli/pkg/globalstate"
 	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/selector"
 	"github.com/exoscale/cli/utils"
 	v3 "github.com/exoscale/egoscale/v3"
 )
 
 type sksClusterListItemOutput struct {
-	ID           v3.UUID     `json:"id" outputWidth:"36"`
-	Name         string      `json:"name" outputWidth:"70"`
-	Zone         v3.ZoneName `json:"zone" outputWidth:"8"`
-	AuditEnabled bool        `json:"audit_enabled" outputWidth:"11"`
+	ID           v3.UUID           `json:"id" outputWidth:"36"`
+	Name         string            `json:"name" outputWidth:"70"`
+	Zone         v3.ZoneName       `json:"zone" outputWidth:"8"`
+	AuditEnabled bool              `json:"audit_enabled" outputWidth:"11"`
+	Labels       map[string]string `json:"labels,omitempty" output:"-" outputWidth:"40"`
 }
 
 type sksListCmd struct {
@@ -28,7 +30,8 @@ type sksListCmd struct {
 
 	_ bool `cli-cmd:"list"`
 
-	Zone v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Zone     v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Selector string      `cli-short:"l" cli-usage:"label selector to filter results with, e.g. \"env=prod,tier in (web,api)\""`
 }
 
 func (c *sksListCmd) CmdAliases() []string { return exocmd.GListAlias }
@@ -51,6 +54,11 @@ func (c *sksListCmd) CmdRun(_ *cobra.Command, _ []string) error {
 }
 
 func runSksList(c *sksListCmd, stdout, stderr io.Writer) error {
+	sel, err := selector.Parse(c.Selector)
+	if err != nil {
+		return err
+	}
+
 	client := globalstate.EgoscaleV3Client
 	ctx := exocmd.GContext
 
@@ -86,6 +94,9 @@ func runSksList(c *sksListCmd, stdout, stderr io.Writer) error {
 				return fmt.Errorf("unable to list SKS clusters in zone %s: %w", zone, err)
 			}
 			for _, cluster := range resp.SKSClusters {
+				if !sel.Matches(cluster.Labels) {
+					continue
+				}
 				if err := streamer.Push(sksClusterListItemOutput{
 					ID:   cluster.ID,
 					Name: cluster.Name,
@@ -93,6 +104,7 @@ func runSksList(c *sksListCmd, stdout, stderr io.Writer) error {
 					AuditEnabled: func() bool {
 						return cluster.Audit != nil && cluster.Audit.Enabled != nil && *cluster.Audit.Enabled
 					}(),
+					Labels: cluster.Labels,
 				}); err != nil {
 					return err
 				}
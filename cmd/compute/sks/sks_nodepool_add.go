@@ -36,11 +36,11 @@ type sksNodepoolAddCmd struct {
 	ImageGcHighThreshold int64    `cli-flag:"image-gc-high-threshold" cli-usage:"the percent of disk usage after which image garbage collection is always run"`
 	ImageGcMinAge        string   `cli-flag:"image-gc-min-age" cli-usage:"maximum age an image can be unused before it is garbage collected"`
 	InstancePrefix       string   `cli-usage:"string to prefix Nodepool member names with"`
-	InstanceType         string   `cli-usage:"Nodepool Compute instances type"`
+	InstanceType         string   `cli-complete:"instance-type" cli-usage:"Nodepool Compute instances type"`
 	Labels               []string `cli-flag:"label" cli-usage:"Nodepool label (format: key=value)"`
 	NvidiaMigProfile     string   `cli-flag:"nvidia-mig-profile" cli-usage:"Nvidia MIG profile to enable on the Nodepool GPUs (e.g. 4g.24gb); the GPU family is inferred from the instance type"`
-	PrivateNetworks      []string `cli-flag:"private-network" cli-usage:"Nodepool Private Network NAME|ID (can be specified multiple times)"`
-	SecurityGroups       []string `cli-flag:"security-group" cli-usage:"Nodepool Security Group NAME|ID (can be specified multiple times)"`
+	PrivateNetworks      []string `cli-flag:"private-network" cli-complete:"private-network" cli-usage:"Nodepool Private Network NAME|ID (can be specified multiple times)"`
+	SecurityGroups       []string `cli-flag:"security-group" cli-complete:"security-group" cli-usage:"Nodepool Security Group NAME|ID (can be specified multiple times)"`
 	Size                 int64    `cli-usage:"Nodepool size"`
 	StorageLvm           bool     `cli-usage:"Create nodes with non-standard partitioning for persistent storage"`
 	Taints               []string `cli-flag:"taint" cli-usage:"Kubernetes taint to apply to Nodepool Nodes (format: KEY=VALUE:EFFECT, can be specified multiple times)"`
@@ -12,17 +12,19 @@ import (
 	exocmd "github.com/exoscale/cli/cmd"
 	"github.com/exoscale/cli/pkg/globalstate"
 	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/selector"
 	"github.com/exoscale/cli/utils"
 	v3 "github.com/exoscale/egoscale/v3"
 )
 
 type sksNodepoolListItemOutput struct {
-	ID      v3.UUID     `json:"id" outputWidth:"36"`
-	Name    string      `json:"name" outputWidth:"70"`
-	Cluster string      `json:"cluster" outputWidth:"38"`
-	Size    int64       `json:"size" outputWidth:"4"`
-	State   string      `json:"state" outputWidth:"12"`
-	Zone    v3.ZoneName `json:"zone" outputWidth:"8"`
+	ID      v3.UUID           `json:"id" outputWidth:"36"`
+	Name    string            `json:"name" outputWidth:"70"`
+	Cluster string            `json:"cluster" outputWidth:"38"`
+	Size    int64             `json:"size" outputWidth:"4"`
+	State   string            `json:"state" outputWidth:"12"`
+	Zone    v3.ZoneName       `json:"zone" outputWidth:"8"`
+	Labels  map[string]string `json:"labels,omitempty" output:"-" outputWidth:"40"`
 }
 
 type sksNodepoolListCmd struct {
@@ -30,7 +32,8 @@ type sksNodepoolListCmd struct {
 
 	_ bool `cli-cmd:"list"`
 
-	Zone v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Zone     v3.ZoneName `cli-short:"z" cli-usage:"zone to filter results to"`
+	Selector string      `cli-short:"l" cli-usage:"label selector to filter results with, e.g. \"env=prod,tier in (web,api)\""`
 }
 
 func (c *sksNodepoolListCmd) CmdAliases() []string { return exocmd.GListAlias }
@@ -53,6 +56,11 @@ func (c *sksNodepoolListCmd) CmdRun(_ *cobra.Command, _ []string) error {
 }
 
 func runSksNodepoolList(c *sksNodepoolListCmd, stdout, stderr io.Writer) error {
+	sel, err := selector.Parse(c.Selector)
+	if err != nil {
+		return err
+	}
+
 	client := globalstate.EgoscaleV3Client
 	ctx := exocmd.GContext
 
@@ -89,6 +97,9 @@ func runSksNodepoolList(c *sksNodepoolListCmd, stdout, stderr io.Writer) error {
 			}
 			for _, cluster := range listResp.SKSClusters {
 				for _, np := range cluster.Nodepools {
+					if !sel.Matches(np.Labels) {
+						continue
+					}
 					if err := streamer.Push(sksNodepoolListItemOutput{
 						ID:      np.ID,
 						Name:    np.Name,
@@ -96,6 +107,7 @@ func runSksNodepoolList(c *sksNodepoolListCmd, stdout, stderr io.Writer) error {
 						Size:    np.Size,
 						State:   string(np.State),
 						Zone:    zone.Name,
+						Labels:  np.Labels,
 					}); err != nil {
 						return err
 					}
@@ -27,12 +27,12 @@ type sksNodepoolUpdateCmd struct {
 	Description        string      `cli-usage:"Nodepool description"`
 	DiskSize           int64       `cli-usage:"Nodepool Compute instances disk size"`
 	InstancePrefix     string      `cli-usage:"string to prefix Nodepool member names with"`
-	InstanceType       string      `cli-usage:"Nodepool Compute instances type"`
+	InstanceType       string      `cli-complete:"instance-type" cli-usage:"Nodepool Compute instances type"`
 	Labels             []string    `cli-flag:"label" cli-usage:"Nodepool label (format: KEY=VALUE, can be repeated multiple times)"`
 	Name               string      `cli-usage:"Nodepool name"`
 	NvidiaMigProfile   string      `cli-flag:"nvidia-mig-profile" cli-usage:"Nvidia MIG profile to enable on the Nodepool GPUs (e.g. 4g.24gb), empty to disable; the GPU family is inferred from the instance type"`
-	PrivateNetworks    []string    `cli-flag:"private-network" cli-usage:"Nodepool Private Network NAME|ID (can be specified multiple times)"`
-	SecurityGroups     []string    `cli-flag:"security-group" cli-usage:"Nodepool Security Group NAME|ID (can be specified multiple times)"`
+	PrivateNetworks    []string    `cli-flag:"private-network" cli-complete:"private-network" cli-usage:"Nodepool Private Network NAME|ID (can be specified multiple times)"`
+	SecurityGroups     []string    `cli-flag:"security-group" cli-complete:"security-group" cli-usage:"Nodepool Security Group NAME|ID (can be specified multiple times)"`
 	Taints             []string    `cli-flag:"taint" cli-usage:"Kubernetes taint to apply to Nodepool Nodes (format: KEY=VALUE:EFFECT, can be specified multiple times)"`
 	Zone               v3.ZoneName `cli-short:"z" cli-usage:"SKS cluster zone"`
 	IPv6               bool        `cli-flag:"ipv6" cli-usage:"Enable public IPv6 assignment to Nodepool nodes"`
@@ -0,0 +1,15 @@
+package vpc
+
+import (
+	"github.com/exoscale/cli/cmd/compute"
+	"github.com/spf13/cobra"
+)
+
+var vpcCmd = &cobra.Command{
+	Use:   "vpc",
+	Short: "Virtual Private Clouds management [BETA]",
+}
+
+func init() {
+	compute.ComputeCmd.AddCommand(vpcCmd)
+}
@@ -0,0 +1,70 @@
+package vpc
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type vpcDeleteCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"delete"`
+
+	VPC string `cli-arg:"#" cli-usage:"NAME|ID"`
+
+	Force bool        `cli-short:"f" cli-usage:"don't prompt for confirmation"`
+	Zone  v3.ZoneName `cli-short:"z" cli-usage:"VPC zone"`
+}
+
+func (c *vpcDeleteCmd) CmdAliases() []string { return exocmd.GRemoveAlias }
+
+func (c *vpcDeleteCmd) CmdShort() string { return "Delete a VPC" }
+
+func (c *vpcDeleteCmd) CmdLong() string { return "" }
+
+func (c *vpcDeleteCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *vpcDeleteCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListVpcs(ctx)
+	if err != nil {
+		return err
+	}
+
+	vpc, err := resp.FindListVpcEntry(c.VPC)
+	if err != nil {
+		return err
+	}
+
+	if !c.Force {
+		if !utils.AskQuestion(ctx, fmt.Sprintf("Are you sure you want to delete VPC %s?", c.VPC)) {
+			return nil
+		}
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Deleting VPC %s...", c.VPC), func() {
+		_, err = client.DeleteVpc(ctx, vpc.ID)
+	})
+
+	return err
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(vpcCmd, &vpcDeleteCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,14 @@
+package vpc
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var vpcRouteCmd = &cobra.Command{
+	Use:   "route",
+	Short: "VPC routes management",
+}
+
+func init() {
+	vpcCmd.AddCommand(vpcRouteCmd)
+}
@@ -0,0 +1,106 @@
+package vpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type vpcRouteShowOutput struct {
+	ID          v3.UUID `json:"id"`
+	Kind        string  `json:"kind"`
+	Destination string  `json:"destination"`
+	Target      string  `json:"target"`
+	Description string  `json:"description"`
+}
+
+func (o *vpcRouteShowOutput) Type() string { return "VPC Route" }
+func (o *vpcRouteShowOutput) ToJSON()      { output.JSON(o) }
+func (o *vpcRouteShowOutput) ToText()      { output.Text(o) }
+func (o *vpcRouteShowOutput) ToTable()     { output.Table(o) }
+
+type vpcRouteAddCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"add"`
+
+	VPC    string `cli-arg:"#" cli-usage:"VPC-NAME|ID"`
+	Subnet string `cli-arg:"#" cli-usage:"SUBNET-NAME|ID"`
+
+	Description string      `cli-usage:"route description"`
+	Destination string      `cli-usage:"route destination (CIDR notation)"`
+	Target      string      `cli-usage:"route target"`
+	Zone        v3.ZoneName `cli-short:"z" cli-usage:"VPC zone"`
+}
+
+func (c *vpcRouteAddCmd) CmdAliases() []string { return exocmd.GCreateAlias }
+
+func (c *vpcRouteAddCmd) CmdShort() string { return "Add a route to a VPC subnet" }
+
+func (c *vpcRouteAddCmd) CmdLong() string {
+	return fmt.Sprintf(`This command adds a route to a VPC subnet.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&vpcRouteShowOutput{}), ", "))
+}
+
+func (c *vpcRouteAddCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *vpcRouteAddCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	if c.Destination == "" || c.Target == "" {
+		return fmt.Errorf("both --%s and --%s must be specified",
+			exocmd.MustCLICommandFlagName(c, &c.Destination),
+			exocmd.MustCLICommandFlagName(c, &c.Target))
+	}
+
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	vpc, subnet, err := findVPCSubnet(ctx, client, c.VPC, c.Subnet)
+	if err != nil {
+		return err
+	}
+
+	var route *v3.Route
+	utils.DecorateAsyncOperation(fmt.Sprintf("Adding route to %s in subnet %q...", c.Destination, c.Subnet), func() {
+		route, err = client.CreateRoute(ctx, vpc.ID, subnet.ID, v3.CreateRouteRequest{
+			Description: c.Description,
+			Destination: c.Destination,
+			Target:      c.Target,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return c.OutputFunc(&vpcRouteShowOutput{
+			ID:          route.ID,
+			Kind:        string(route.Kind),
+			Destination: route.Destination,
+			Target:      route.Target,
+			Description: route.Description,
+		}, nil)
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(vpcRouteCmd, &vpcRouteAddCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,77 @@
+package vpc
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type vpcRouteDeleteCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"delete"`
+
+	VPC    string `cli-arg:"#" cli-usage:"VPC-NAME|ID"`
+	Subnet string `cli-arg:"#" cli-usage:"SUBNET-NAME|ID"`
+	Route  string `cli-arg:"#" cli-usage:"ROUTE-ID"`
+
+	Force bool        `cli-short:"f" cli-usage:"don't prompt for confirmation"`
+	Zone  v3.ZoneName `cli-short:"z" cli-usage:"VPC zone"`
+}
+
+func (c *vpcRouteDeleteCmd) CmdAliases() []string { return exocmd.GRemoveAlias }
+
+func (c *vpcRouteDeleteCmd) CmdShort() string { return "Delete a VPC subnet route" }
+
+func (c *vpcRouteDeleteCmd) CmdLong() string { return "" }
+
+func (c *vpcRouteDeleteCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *vpcRouteDeleteCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	vpc, subnet, err := findVPCSubnet(ctx, client, c.VPC, c.Subnet)
+	if err != nil {
+		return err
+	}
+
+	routes, err := client.ListRoutes(ctx, vpc.ID, subnet.ID)
+	if err != nil {
+		return err
+	}
+
+	route, err := routes.FindListRouteEntry(c.Route)
+	if err != nil {
+		return err
+	}
+
+	if !c.Force {
+		if !utils.AskQuestion(ctx, fmt.Sprintf("Are you sure you want to delete route %s (%s)?", c.Route, route.Destination)) {
+			return nil
+		}
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Deleting route %s...", c.Route), func() {
+		_, err = client.DeleteRoute(ctx, vpc.ID, subnet.ID, route.ID)
+	})
+
+	return err
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(vpcRouteCmd, &vpcRouteDeleteCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
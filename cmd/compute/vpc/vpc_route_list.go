@@ -0,0 +1,110 @@
+package vpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type vpcRouteListItemOutput struct {
+	ID          v3.UUID `json:"id"`
+	Kind        string  `json:"kind"`
+	Destination string  `json:"destination"`
+	Target      string  `json:"target"`
+	Description string  `json:"description"`
+}
+
+type vpcRouteListOutput []vpcRouteListItemOutput
+
+func (o *vpcRouteListOutput) ToJSON()  { output.JSON(o) }
+func (o *vpcRouteListOutput) ToText()  { output.Text(o) }
+func (o *vpcRouteListOutput) ToTable() { output.Table(o) }
+
+type vpcRouteListCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"list"`
+
+	VPC string `cli-arg:"#" cli-usage:"VPC-NAME|ID"`
+
+	Subnet string      `cli-usage:"only list the routes of this subnet (name or ID)"`
+	Zone   v3.ZoneName `cli-short:"z" cli-usage:"VPC zone"`
+}
+
+func (c *vpcRouteListCmd) CmdAliases() []string { return exocmd.GListAlias }
+
+func (c *vpcRouteListCmd) CmdShort() string { return "List VPC routes" }
+
+func (c *vpcRouteListCmd) CmdLong() string {
+	return fmt.Sprintf(`This command lists the routes of a Virtual Private Cloud, optionally
+restricted to a single subnet.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&vpcRouteListItemOutput{}), ", "))
+}
+
+func (c *vpcRouteListCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *vpcRouteListCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	var routes []v3.ListRouteEntry
+
+	if c.Subnet != "" {
+		vpc, subnet, err := findVPCSubnet(ctx, client, c.VPC, c.Subnet)
+		if err != nil {
+			return err
+		}
+		resp, err := client.ListRoutes(ctx, vpc.ID, subnet.ID)
+		if err != nil {
+			return err
+		}
+		routes = resp.Routes
+	} else {
+		vpcs, err := client.ListVpcs(ctx)
+		if err != nil {
+			return err
+		}
+		vpc, err := vpcs.FindListVpcEntry(c.VPC)
+		if err != nil {
+			return err
+		}
+		resp, err := client.ListVpcRoutes(ctx, vpc.ID)
+		if err != nil {
+			return err
+		}
+		routes = resp.Routes
+	}
+
+	out := make(vpcRouteListOutput, 0, len(routes))
+	for _, r := range routes {
+		out = append(out, vpcRouteListItemOutput{
+			ID:          r.ID,
+			Kind:        string(r.Kind),
+			Destination: r.Destination,
+			Target:      r.Target,
+			Description: r.Description,
+		})
+	}
+
+	return c.OutputFunc(&out, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(vpcRouteCmd, &vpcRouteListCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
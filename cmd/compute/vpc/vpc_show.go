@@ -0,0 +1,105 @@
+package vpc
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type vpcShowOutput struct {
+	ID          v3.UUID           `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	CreatedAT   time.Time         `json:"created-at"`
+	Labels      map[string]string `json:"labels"`
+	Subnets     []string          `json:"subnets"`
+	Zone        v3.ZoneName       `json:"zone"`
+}
+
+func (o *vpcShowOutput) Type() string { return "VPC" }
+func (o *vpcShowOutput) ToJSON()      { output.JSON(o) }
+func (o *vpcShowOutput) ToText()      { output.Text(o) }
+func (o *vpcShowOutput) ToTable()     { output.Table(o) }
+
+type vpcShowCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"show"`
+
+	VPC string `cli-arg:"#" cli-usage:"NAME|ID"`
+
+	Zone v3.ZoneName `cli-short:"z" cli-usage:"VPC zone"`
+}
+
+func (c *vpcShowCmd) CmdAliases() []string { return exocmd.GShowAlias }
+
+func (c *vpcShowCmd) CmdShort() string { return "Show a VPC details" }
+
+func (c *vpcShowCmd) CmdLong() string {
+	return fmt.Sprintf(`This command shows a Virtual Private Cloud details.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&vpcShowOutput{}), ", "))
+}
+
+func (c *vpcShowCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *vpcShowCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListVpcs(ctx)
+	if err != nil {
+		return err
+	}
+
+	entry, err := resp.FindListVpcEntry(c.VPC)
+	if err != nil {
+		return err
+	}
+
+	vpc, err := client.GetVpc(ctx, entry.ID)
+	if err != nil {
+		return err
+	}
+
+	subnets, err := client.ListSubnets(ctx, vpc.ID)
+	if err != nil {
+		return fmt.Errorf("unable to list VPC subnets: %w", err)
+	}
+
+	out := vpcShowOutput{
+		ID:          vpc.ID,
+		Name:        vpc.Name,
+		Description: vpc.Description,
+		CreatedAT:   vpc.CreatedAT,
+		Labels:      vpc.Labels,
+		Subnets:     make([]string, 0, len(subnets.Subnets)),
+		Zone:        c.Zone,
+	}
+
+	for _, s := range subnets.Subnets {
+		out.Subnets = append(out.Subnets, s.Name)
+	}
+
+	return c.OutputFunc(&out, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(vpcCmd, &vpcShowCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,46 @@
+package vpc
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+var vpcSubnetCmd = &cobra.Command{
+	Use:   "subnet",
+	Short: "VPC subnets management",
+}
+
+func init() {
+	vpcCmd.AddCommand(vpcSubnetCmd)
+}
+
+// findVPCSubnet resolves a VPC and one of its subnets by name or ID.
+func findVPCSubnet(
+	ctx context.Context,
+	client *v3.Client,
+	vpcNameOrID string,
+	subnetNameOrID string,
+) (v3.ListVpcEntry, v3.ListSubnetEntry, error) {
+	vpcs, err := client.ListVpcs(ctx)
+	if err != nil {
+		return v3.ListVpcEntry{}, v3.ListSubnetEntry{}, err
+	}
+	vpc, err := vpcs.FindListVpcEntry(vpcNameOrID)
+	if err != nil {
+		return v3.ListVpcEntry{}, v3.ListSubnetEntry{}, err
+	}
+
+	subnets, err := client.ListSubnets(ctx, vpc.ID)
+	if err != nil {
+		return v3.ListVpcEntry{}, v3.ListSubnetEntry{}, err
+	}
+	subnet, err := subnets.FindListSubnetEntry(subnetNameOrID)
+	if err != nil {
+		return v3.ListVpcEntry{}, v3.ListSubnetEntry{}, err
+	}
+
+	return vpc, subnet, nil
+}
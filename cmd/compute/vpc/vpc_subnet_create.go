@@ -0,0 +1,98 @@
+package vpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type vpcSubnetCreateCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"create"`
+
+	VPC  string `cli-arg:"#" cli-usage:"VPC-NAME|ID"`
+	Name string `cli-arg:"#"`
+
+	Description string            `cli-usage:"subnet description"`
+	IPv4Block   string            `cli-flag:"ipv4-block" cli-usage:"subnet IPv4 block (CIDR notation)"`
+	Labels      map[string]string `cli-flag:"label" cli-usage:"subnet label (format: key=value)"`
+	Zone        v3.ZoneName       `cli-short:"z" cli-usage:"VPC zone"`
+}
+
+func (c *vpcSubnetCreateCmd) CmdAliases() []string { return exocmd.GCreateAlias }
+
+func (c *vpcSubnetCreateCmd) CmdShort() string { return "Create a VPC subnet" }
+
+func (c *vpcSubnetCreateCmd) CmdLong() string {
+	return fmt.Sprintf(`This command creates a subnet in a Virtual Private Cloud.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&vpcSubnetShowOutput{}), ", "))
+}
+
+func (c *vpcSubnetCreateCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *vpcSubnetCreateCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	vpcs, err := client.ListVpcs(ctx)
+	if err != nil {
+		return err
+	}
+
+	vpc, err := vpcs.FindListVpcEntry(c.VPC)
+	if err != nil {
+		return err
+	}
+
+	op, err := client.CreateSubnet(ctx, vpc.ID, v3.CreateSubnetRequest{
+		AddressSpace:  v3.CreateSubnetRequestAddressSpacePrivate,
+		Addressfamily: v3.CreateSubnetRequestAddressfamilyInet4,
+		Description:   c.Description,
+		Ipv4Block:     c.IPv4Block,
+		Labels:        c.Labels,
+		Name:          c.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Creating subnet %q in VPC %q...", c.Name, c.VPC), func() {
+		op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return (&vpcSubnetShowCmd{
+			CliCommandSettings: c.CliCommandSettings,
+			VPC:                vpc.ID.String(),
+			Subnet:             op.Reference.ID.String(),
+			Zone:               c.Zone,
+		}).CmdRun(nil, nil)
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(vpcSubnetCmd, &vpcSubnetCreateCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
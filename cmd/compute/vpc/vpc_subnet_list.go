@@ -0,0 +1,103 @@
+package vpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/selector"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type vpcSubnetListItemOutput struct {
+	ID        v3.UUID           `json:"id"`
+	Name      string            `json:"name"`
+	IPv4Block string            `json:"ipv4-block"`
+	Labels    map[string]string `json:"labels,omitempty" output:"-"`
+}
+
+type vpcSubnetListOutput []vpcSubnetListItemOutput
+
+func (o *vpcSubnetListOutput) ToJSON()  { output.JSON(o) }
+func (o *vpcSubnetListOutput) ToText()  { output.Text(o) }
+func (o *vpcSubnetListOutput) ToTable() { output.Table(o) }
+
+type vpcSubnetListCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"list"`
+
+	VPC string `cli-arg:"#" cli-usage:"VPC-NAME|ID"`
+
+	Zone     v3.ZoneName `cli-short:"z" cli-usage:"VPC zone"`
+	Selector string      `cli-short:"l" cli-usage:"label selector to filter results with, e.g. \"env=prod,tier in (web,api)\""`
+}
+
+func (c *vpcSubnetListCmd) CmdAliases() []string { return exocmd.GListAlias }
+
+func (c *vpcSubnetListCmd) CmdShort() string { return "List VPC subnets" }
+
+func (c *vpcSubnetListCmd) CmdLong() string {
+	return fmt.Sprintf(`This command lists the subnets of a Virtual Private Cloud.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&vpcSubnetListItemOutput{}), ", "))
+}
+
+func (c *vpcSubnetListCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *vpcSubnetListCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	sel, err := selector.Parse(c.Selector)
+	if err != nil {
+		return err
+	}
+
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	vpcs, err := client.ListVpcs(ctx)
+	if err != nil {
+		return err
+	}
+
+	vpc, err := vpcs.FindListVpcEntry(c.VPC)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListSubnets(ctx, vpc.ID)
+	if err != nil {
+		return err
+	}
+
+	out := make(vpcSubnetListOutput, 0, len(resp.Subnets))
+	for _, s := range resp.Subnets {
+		if !sel.Matches(s.Labels) {
+			continue
+		}
+		out = append(out, vpcSubnetListItemOutput{
+			ID:        s.ID,
+			Name:      s.Name,
+			IPv4Block: s.Ipv4Block,
+			Labels:    s.Labels,
+		})
+	}
+
+	return c.OutputFunc(&out, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(vpcSubnetCmd, &vpcSubnetListCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
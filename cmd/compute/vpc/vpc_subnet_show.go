@@ -0,0 +1,96 @@
+package vpc
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type vpcSubnetShowOutput struct {
+	ID            v3.UUID           `json:"id"`
+	Name          string            `json:"name"`
+	Description   string            `json:"description"`
+	VPC           string            `json:"vpc"`
+	AddressFamily string            `json:"address-family"`
+	AddressSpace  string            `json:"address-space"`
+	IPv4Block     string            `json:"ipv4-block"`
+	CreatedAT     time.Time         `json:"created-at"`
+	Labels        map[string]string `json:"labels"`
+	Zone          v3.ZoneName       `json:"zone"`
+}
+
+func (o *vpcSubnetShowOutput) Type() string { return "VPC Subnet" }
+func (o *vpcSubnetShowOutput) ToJSON()      { output.JSON(o) }
+func (o *vpcSubnetShowOutput) ToText()      { output.Text(o) }
+func (o *vpcSubnetShowOutput) ToTable()     { output.Table(o) }
+
+type vpcSubnetShowCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"show"`
+
+	VPC    string `cli-arg:"#" cli-usage:"VPC-NAME|ID"`
+	Subnet string `cli-arg:"#" cli-usage:"SUBNET-NAME|ID"`
+
+	Zone v3.ZoneName `cli-short:"z" cli-usage:"VPC zone"`
+}
+
+func (c *vpcSubnetShowCmd) CmdAliases() []string { return exocmd.GShowAlias }
+
+func (c *vpcSubnetShowCmd) CmdShort() string { return "Show a VPC subnet details" }
+
+func (c *vpcSubnetShowCmd) CmdLong() string {
+	return fmt.Sprintf(`This command shows a VPC subnet details.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&vpcSubnetShowOutput{}), ", "))
+}
+
+func (c *vpcSubnetShowCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *vpcSubnetShowCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	vpc, entry, err := findVPCSubnet(ctx, client, c.VPC, c.Subnet)
+	if err != nil {
+		return err
+	}
+
+	subnet, err := client.GetSubnet(ctx, vpc.ID, entry.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.OutputFunc(&vpcSubnetShowOutput{
+		ID:            subnet.ID,
+		Name:          subnet.Name,
+		Description:   subnet.Description,
+		VPC:           vpc.Name,
+		AddressFamily: string(subnet.Addressfamily),
+		AddressSpace:  string(subnet.AddressSpace),
+		IPv4Block:     subnet.Ipv4Block,
+		CreatedAT:     subnet.CreatedAT,
+		Labels:        subnet.Labels,
+		Zone:          c.Zone,
+	}, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(vpcSubnetCmd, &vpcSubnetShowCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
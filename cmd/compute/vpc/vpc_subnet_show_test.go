@@ -0,0 +1,102 @@
+package vpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/testutils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+const (
+	testVPCID    = v3.UUID("11111111-1111-1111-1111-111111111111")
+	testSubnetID = v3.UUID("22222222-2222-2222-2222-222222222222")
+)
+
+func newVPCTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/vpc", func(w http.ResponseWriter, _ *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListVpcsResponse{
+			Vpcs: []v3.ListVpcEntry{{ID: testVPCID, Name: "my-vpc"}},
+		})
+	})
+	mux.HandleFunc("/vpc/"+testVPCID.String()+"/subnet", func(w http.ResponseWriter, _ *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListSubnetsResponse{
+			Subnets: []v3.ListSubnetEntry{{ID: testSubnetID, Name: "my-subnet"}},
+		})
+	})
+	mux.HandleFunc("/vpc/"+testVPCID.String()+"/subnet/"+testSubnetID.String(), func(w http.ResponseWriter, _ *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Subnet{
+			ID:            testSubnetID,
+			Name:          "my-subnet",
+			AddressSpace:  v3.SubnetAddressSpacePrivate,
+			Addressfamily: v3.SubnetAddressfamilyInet4,
+			Ipv4Block:     "10.0.0.0/24",
+			Labels:        v3.Labels{"env": "test"},
+		})
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestVPCSubnetShow(t *testing.T) {
+	server := newVPCTestServer(t)
+	defer server.Close()
+	testutils.SetupV3Client(t, server.URL)
+
+	for _, tt := range []struct {
+		name   string
+		vpc    string
+		subnet string
+	}{
+		{name: "by name", vpc: "my-vpc", subnet: "my-subnet"},
+		{name: "by ID", vpc: testVPCID.String(), subnet: testSubnetID.String()},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &vpcSubnetShowCmd{
+				CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+				VPC:                tt.vpc,
+				Subnet:             tt.subnet,
+			}
+
+			var got *vpcSubnetShowOutput
+			cmd.OutputFunc = func(o output.Outputter, err error) error {
+				if err != nil {
+					return err
+				}
+				got = o.(*vpcSubnetShowOutput)
+				return nil
+			}
+
+			if err := cmd.CmdRun(nil, nil); err != nil {
+				t.Fatalf("subnet show: %v", err)
+			}
+			if got.ID != testSubnetID || got.VPC != "my-vpc" || got.IPv4Block != "10.0.0.0/24" {
+				t.Fatalf("unexpected subnet show output: %+v", got)
+			}
+			if got.Labels["env"] != "test" {
+				t.Errorf("expected label env=test, got %v", got.Labels)
+			}
+		})
+	}
+}
+
+func TestVPCSubnetShowNotFound(t *testing.T) {
+	server := newVPCTestServer(t)
+	defer server.Close()
+	testutils.SetupV3Client(t, server.URL)
+
+	cmd := &vpcSubnetShowCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+		VPC:                "my-vpc",
+		Subnet:             "unknown",
+	}
+	if err := cmd.CmdRun(nil, nil); err == nil {
+		t.Fatal("expected an error for an unknown subnet")
+	}
+}
@@ -0,0 +1,108 @@
+package vpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type vpcSubnetUpdateCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"update"`
+
+	VPC    string `cli-arg:"#" cli-usage:"VPC-NAME|ID"`
+	Subnet string `cli-arg:"#" cli-usage:"SUBNET-NAME|ID"`
+
+	Description string            `cli-usage:"subnet description"`
+	IPv4Block   string            `cli-flag:"ipv4-block" cli-usage:"subnet IPv4 block (CIDR notation)"`
+	Labels      map[string]string `cli-flag:"label" cli-usage:"subnet label (format: key=value), clearing the labels is possible by passing [=]"`
+	Name        string            `cli-usage:"subnet name"`
+	Zone        v3.ZoneName       `cli-short:"z" cli-usage:"VPC zone"`
+}
+
+func (c *vpcSubnetUpdateCmd) CmdAliases() []string { return nil }
+
+func (c *vpcSubnetUpdateCmd) CmdShort() string { return "Update a VPC subnet" }
+
+func (c *vpcSubnetUpdateCmd) CmdLong() string {
+	return fmt.Sprintf(`This command updates a VPC subnet.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&vpcSubnetShowOutput{}), ", "))
+}
+
+func (c *vpcSubnetUpdateCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *vpcSubnetUpdateCmd) CmdRun(cmd *cobra.Command, _ []string) error {
+	var updated bool
+
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	vpc, subnet, err := findVPCSubnet(ctx, client, c.VPC, c.Subnet)
+	if err != nil {
+		return err
+	}
+
+	updateReq := v3.UpdateSubnetRequest{Labels: subnet.Labels}
+
+	if cmd.Flags().Changed(exocmd.MustCLICommandFlagName(c, &c.Description)) {
+		updateReq.Description = &c.Description
+		updated = true
+	}
+
+	if cmd.Flags().Changed(exocmd.MustCLICommandFlagName(c, &c.IPv4Block)) {
+		updateReq.Ipv4Block = &c.IPv4Block
+		updated = true
+	}
+
+	if cmd.Flags().Changed(exocmd.MustCLICommandFlagName(c, &c.Labels)) {
+		updateReq.Labels = exocmd.ConvertIfSpecialEmptyMap(c.Labels)
+		updated = true
+	}
+
+	if cmd.Flags().Changed(exocmd.MustCLICommandFlagName(c, &c.Name)) {
+		updateReq.Name = &c.Name
+		updated = true
+	}
+
+	if updated {
+		utils.DecorateAsyncOperation(fmt.Sprintf("Updating subnet %q...", c.Subnet), func() {
+			_, err = client.UpdateSubnet(ctx, vpc.ID, subnet.ID, updateReq)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !globalstate.Quiet {
+		return (&vpcSubnetShowCmd{
+			CliCommandSettings: c.CliCommandSettings,
+			VPC:                vpc.ID.String(),
+			Subnet:             subnet.ID.String(),
+			Zone:               c.Zone,
+		}).CmdRun(nil, nil)
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(vpcSubnetCmd, &vpcSubnetUpdateCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -171,6 +171,27 @@ func saveConfig(filePath string, newAccounts *account.Config) error {
 	return nil
 }
 
+// SaveAccount adds an account to the configuration file currently in use,
+// replacing any existing account with the same name.
+func SaveAccount(acc account.Account) error {
+	filePath := exocmd.GConfig.ConfigFileUsed()
+	if filePath == "" {
+		return fmt.Errorf("no configuration file in use")
+	}
+
+	if account.GAllAccount != nil {
+		accounts := make([]account.Account, 0, len(account.GAllAccount.Accounts))
+		for _, a := range account.GAllAccount.Accounts {
+			if a.Name != acc.Name {
+				accounts = append(accounts, a)
+			}
+		}
+		account.GAllAccount.Accounts = accounts
+	}
+
+	return saveConfig(filePath, &account.Config{Accounts: []account.Account{acc}})
+}
+
 func createConfigFile(fileName string) (string, error) {
 	if _, err := os.Stat(globalstate.ConfigFolder); os.IsNotExist(err) {
 		if err := os.MkdirAll(globalstate.ConfigFolder, os.ModePerm); err != nil {
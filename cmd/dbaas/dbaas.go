@@ -184,3 +184,22 @@ func dbaasGetV3(ctx context.Context, name, zone string) (v3.DBAASServiceCommon,
 
 	return v3.DBAASServiceCommon{}, fmt.Errorf("%q Database Service not found in zone %q", name, zone)
 }
+
+// dbaasGetServicePG retrieves a Database Service, ensuring it is of type pg.
+func dbaasGetServicePG(ctx context.Context, name, zone string) (*v3.DBAASServicePG, error) {
+	db, err := dbaasGetV3(ctx, name, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	if db.Type != "pg" {
+		return nil, fmt.Errorf("%q is not a PostgreSQL Database Service (type %q)", name, db.Type)
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(zone))
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetDBAASServicePG(ctx, name)
+}
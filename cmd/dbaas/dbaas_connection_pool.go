@@ -0,0 +1,66 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+var dbaasConnectionPoolCmd = &cobra.Command{
+	Use:   "connection-pool",
+	Short: "Manage PostgreSQL Database Service connection pools",
+}
+
+func init() {
+	dbaasCmd.AddCommand(dbaasConnectionPoolCmd)
+}
+
+type dbaasConnectionPoolListItemOutput struct {
+	Name          string `json:"name"`
+	Database      string `json:"database"`
+	Username      string `json:"username"`
+	Mode          string `json:"mode"`
+	Size          int64  `json:"size"`
+	ConnectionURI string `json:"connection_uri"`
+}
+
+type dbaasConnectionPoolListOutput []dbaasConnectionPoolListItemOutput
+
+func (o *dbaasConnectionPoolListOutput) ToJSON()  { output.JSON(o) }
+func (o *dbaasConnectionPoolListOutput) ToText()  { output.Text(o) }
+func (o *dbaasConnectionPoolListOutput) ToTable() { output.Table(o) }
+
+// dbaasListConnectionPools returns the connection pools of a pg Database Service.
+func dbaasListConnectionPools(ctx context.Context, name, zone string) (output.Outputter, error) {
+	s, err := dbaasGetServicePG(ctx, name, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(dbaasConnectionPoolListOutput, 0, len(s.ConnectionPools))
+	for _, p := range s.ConnectionPools {
+		out = append(out, dbaasConnectionPoolListItemOutput{
+			Name:          string(p.Name),
+			Database:      string(p.Database),
+			Username:      string(p.Username),
+			Mode:          string(p.Mode),
+			Size:          int64(p.Size),
+			ConnectionURI: p.ConnectionURI,
+		})
+	}
+
+	return &out, nil
+}
+
+func dbaasValidateConnectionPoolMode(mode string) error {
+	switch v3.EnumPGPoolMode(mode) {
+	case "", v3.EnumPGPoolModeTransaction, v3.EnumPGPoolModeStatement, v3.EnumPGPoolModeSession:
+		return nil
+	}
+
+	return fmt.Errorf("invalid pool mode %q, supported values: transaction, statement, session", mode)
+}
@@ -0,0 +1,91 @@
+package dbaas
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasConnectionPoolCreateCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"create"`
+
+	Name string `cli-arg:"#"`
+	Pool string `cli-arg:"#" cli-usage:"POOL-NAME"`
+
+	Database string `cli-usage:"name of the database the pool connects to"`
+	Mode     string `cli-usage:"pool mode (transaction|statement|session)"`
+	Size     int64  `cli-usage:"number of connections of the pool"`
+	Username string `cli-usage:"name of the user used to connect to the database (default: the connecting client user)"`
+	Zone     string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasConnectionPoolCreateCmd) CmdAliases() []string { return exocmd.GCreateAlias }
+
+func (c *dbaasConnectionPoolCreateCmd) CmdShort() string { return "Create DBAAS connection pool" }
+
+func (c *dbaasConnectionPoolCreateCmd) CmdLong() string {
+	return `This command creates a PgBouncer connection pool for a PostgreSQL Database Service.`
+}
+
+func (c *dbaasConnectionPoolCreateCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasConnectionPoolCreateCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	if c.Database == "" {
+		return fmt.Errorf("--database must be specified")
+	}
+	if err := dbaasValidateConnectionPoolMode(c.Mode); err != nil {
+		return err
+	}
+
+	ctx := exocmd.GContext
+	if _, err := dbaasGetServicePG(ctx, c.Name, c.Zone); err != nil {
+		return err
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	op, err := client.CreateDBAASPGConnectionPool(ctx, c.Name, v3.CreateDBAASPGConnectionPoolRequest{
+		Name:         v3.DBAASPGPoolName(c.Pool),
+		DatabaseName: v3.DBAASDatabaseName(c.Database),
+		Mode:         v3.EnumPGPoolMode(c.Mode),
+		Size:         v3.DBAASPGPoolSize(c.Size),
+		Username:     v3.DBAASPGPoolUsername(c.Username),
+	})
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Creating DBaaS connection pool %q", c.Pool), func() {
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return c.OutputFunc(dbaasListConnectionPools(ctx, c.Name, c.Zone))
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasConnectionPoolCmd, &dbaasConnectionPoolCreateCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+		Mode:               string(v3.EnumPGPoolModeTransaction),
+		Size:               10,
+	}))
+}
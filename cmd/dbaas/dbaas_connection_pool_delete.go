@@ -0,0 +1,83 @@
+package dbaas
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasConnectionPoolDeleteCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"delete"`
+
+	Name string `cli-arg:"#"`
+	Pool string `cli-arg:"#" cli-usage:"POOL-NAME"`
+
+	Force bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
+	Zone  string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasConnectionPoolDeleteCmd) CmdAliases() []string { return exocmd.GDeleteAlias }
+
+func (c *dbaasConnectionPoolDeleteCmd) CmdShort() string { return "Delete DBAAS connection pool" }
+
+func (c *dbaasConnectionPoolDeleteCmd) CmdLong() string {
+	return `This command deletes a PgBouncer connection pool of a PostgreSQL Database Service.`
+}
+
+func (c *dbaasConnectionPoolDeleteCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasConnectionPoolDeleteCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	s, err := dbaasGetServicePG(ctx, c.Name, c.Zone)
+	if err != nil {
+		return err
+	}
+	if err := dbaasCheckConnectionPoolExists(s, c.Pool); err != nil {
+		return err
+	}
+
+	if !c.Force {
+		if !utils.AskQuestion(ctx, fmt.Sprintf("Are you sure you want to delete connection pool %q", c.Pool)) {
+			return nil
+		}
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	op, err := client.DeleteDBAASPGConnectionPool(ctx, c.Name, c.Pool)
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Deleting DBaaS connection pool %q", c.Pool), func() {
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return c.OutputFunc(dbaasListConnectionPools(ctx, c.Name, c.Zone))
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasConnectionPoolCmd, &dbaasConnectionPoolDeleteCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,46 @@
+package dbaas
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/output"
+)
+
+type dbaasConnectionPoolListCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"list"`
+
+	Name string `cli-arg:"#"`
+	Zone string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasConnectionPoolListCmd) CmdAliases() []string { return exocmd.GListAlias }
+
+func (c *dbaasConnectionPoolListCmd) CmdShort() string { return "List DBAAS connection pools" }
+
+func (c *dbaasConnectionPoolListCmd) CmdLong() string {
+	return fmt.Sprintf(`This command lists the PgBouncer connection pools of a PostgreSQL Database Service.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&dbaasConnectionPoolListItemOutput{}), ", "))
+}
+
+func (c *dbaasConnectionPoolListCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasConnectionPoolListCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	return c.OutputFunc(dbaasListConnectionPools(exocmd.GContext, c.Name, c.Zone))
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasConnectionPoolCmd, &dbaasConnectionPoolListCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,195 @@
+package dbaas
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/testutils"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pgPoolTestServer struct {
+	*httptest.Server
+
+	createReq  v3.CreateDBAASPGConnectionPoolRequest
+	updateReq  v3.UpdateDBAASPGConnectionPoolRequest
+	deleted    bool
+	taskPolls  int
+	taskResult v3.DBAASTask
+}
+
+func setupPGPoolTestServer(t *testing.T) *pgPoolTestServer {
+	t.Helper()
+
+	ts := &pgPoolTestServer{}
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/zone", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListZonesResponse{
+			Zones: []v3.Zone{{APIEndpoint: v3.Endpoint(ts.URL), Name: v3.ZoneName("test-zone")}},
+		})
+	})
+
+	mux.HandleFunc("/dbaas-service", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListDBAASServicesResponse{
+			DBAASServices: []v3.DBAASServiceCommon{
+				{Name: "testdb", Type: "pg"},
+				{Name: "testmysql", Type: "mysql"},
+			},
+		})
+	})
+
+	mux.HandleFunc("/dbaas-postgres/testdb", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.DBAASServicePG{
+			Name:    "testdb",
+			Version: "15",
+			ConnectionPools: []v3.DBAASServicePGConnectionPools{{
+				Name:     "pool1",
+				Database: "defaultdb",
+				Username: "avnadmin",
+				Mode:     v3.EnumPGPoolModeTransaction,
+				Size:     10,
+			}},
+		})
+	})
+
+	mux.HandleFunc("/dbaas-postgres/testdb/connection-pool", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&ts.createReq))
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op-pool"), State: v3.OperationStateSuccess})
+	})
+
+	mux.HandleFunc("/dbaas-postgres/testdb/connection-pool/pool1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&ts.updateReq))
+		case http.MethodDelete:
+			ts.deleted = true
+		}
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op-pool"), State: v3.OperationStateSuccess})
+	})
+
+	mux.HandleFunc("/dbaas-postgres/testdb/upgrade-check", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.DBAASTask{ID: v3.UUID("11111111-1111-1111-1111-111111111111")})
+	})
+
+	mux.HandleFunc("/dbaas-task/testdb/11111111-1111-1111-1111-111111111111", func(w http.ResponseWriter, r *http.Request) {
+		ts.taskPolls++
+		if ts.taskPolls < 2 {
+			testutils.WriteJSON(t, w, http.StatusOK, v3.DBAASTask{ID: v3.UUID("11111111-1111-1111-1111-111111111111")})
+			return
+		}
+		testutils.WriteJSON(t, w, http.StatusOK, ts.taskResult)
+	})
+
+	mux.HandleFunc("/operation/", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op-pool"), State: v3.OperationStateSuccess})
+	})
+
+	ts.Server = httptest.NewServer(mux)
+	return ts
+}
+
+// runDBAASTestCommand registers a command through register on a fresh root
+// command, and executes it with the arguments specified.
+func runDBAASTestCommand(t *testing.T, register func(*cobra.Command) error, args ...string) error {
+	t.Helper()
+
+	rootCmd := &cobra.Command{}
+	require.NoError(t, register(rootCmd))
+	rootCmd.SetArgs(args)
+
+	return rootCmd.Execute()
+}
+
+func TestDBAASConnectionPool(t *testing.T) {
+	ts := setupPGPoolTestServer(t)
+	defer ts.Close()
+	testutils.SetupV3Client(t, ts.URL)
+
+	settings := exocmd.DefaultCLICmdSettings()
+
+	err := runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasConnectionPoolCreateCmd{CliCommandSettings: settings, Mode: "transaction", Size: 10})
+	}, "create", "testdb", "pool2", "--database", "defaultdb", "--size", "20", "--zone", "test-zone")
+	require.NoError(t, err)
+	assert.Equal(t, v3.CreateDBAASPGConnectionPoolRequest{
+		Name:         "pool2",
+		DatabaseName: "defaultdb",
+		Mode:         v3.EnumPGPoolModeTransaction,
+		Size:         20,
+	}, ts.createReq)
+
+	err = runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasConnectionPoolCreateCmd{CliCommandSettings: settings})
+	}, "create", "testdb", "pool2", "--zone", "test-zone")
+	assert.ErrorContains(t, err, "--database must be specified")
+
+	err = runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasConnectionPoolCreateCmd{CliCommandSettings: settings})
+	}, "create", "testmysql", "pool2", "--database", "defaultdb", "--zone", "test-zone")
+	assert.ErrorContains(t, err, "not a PostgreSQL Database Service")
+
+	err = runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasConnectionPoolUpdateCmd{CliCommandSettings: settings})
+	}, "update", "testdb", "pool1", "--mode", "session", "--zone", "test-zone")
+	require.NoError(t, err)
+	assert.Equal(t, v3.UpdateDBAASPGConnectionPoolRequest{Mode: v3.EnumPGPoolModeSession}, ts.updateReq)
+
+	err = runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasConnectionPoolUpdateCmd{CliCommandSettings: settings})
+	}, "update", "testdb", "missing", "--size", "5", "--zone", "test-zone")
+	assert.ErrorContains(t, err, `connection pool "missing" not found`)
+
+	err = runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasConnectionPoolDeleteCmd{CliCommandSettings: settings})
+	}, "delete", "testdb", "pool1", "--force", "--zone", "test-zone")
+	require.NoError(t, err)
+	assert.True(t, ts.deleted)
+}
+
+func TestDBAASUpgradeCheck(t *testing.T) {
+	defer func(d time.Duration) { dbaasTaskPollInterval = d }(dbaasTaskPollInterval)
+	dbaasTaskPollInterval = time.Millisecond
+
+	ts := setupPGPoolTestServer(t)
+	defer ts.Close()
+	testutils.SetupV3Client(t, ts.URL)
+
+	var out output.Outputter
+	settings := exocmd.DefaultCLICmdSettings()
+	settings.OutputFunc = func(o output.Outputter, err error) error {
+		out = o
+		return err
+	}
+
+	success := false
+	ts.taskResult = v3.DBAASTask{
+		ID:          v3.UUID("11111111-1111-1111-1111-111111111111"),
+		Success:     &success,
+		Result:      "upgrade blocked",
+		ResultCodes: []v3.DBAASTaskResultCodes{{Code: "unsupported_extension", Dbname: "app"}},
+	}
+
+	err := runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasUpgradeCheckCmd{CliCommandSettings: settings})
+	}, "upgrade-check", "testdb", "--target-version", "16", "--zone", "test-zone")
+	assert.ErrorContains(t, err, "is not possible")
+	assert.Equal(t, &dbaasUpgradeCheckOutput{
+		Name:           "testdb",
+		CurrentVersion: "15",
+		TargetVersion:  "16",
+		Success:        false,
+		Result:         "upgrade blocked",
+		Blockers:       []string{"unsupported_extension (database app)"},
+	}, out)
+	assert.Equal(t, 2, ts.taskPolls)
+}
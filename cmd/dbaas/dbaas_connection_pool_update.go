@@ -0,0 +1,122 @@
+package dbaas
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasConnectionPoolUpdateCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"update"`
+
+	Name string `cli-arg:"#"`
+	Pool string `cli-arg:"#" cli-usage:"POOL-NAME"`
+
+	Database string `cli-usage:"name of the database the pool connects to"`
+	Mode     string `cli-usage:"pool mode (transaction|statement|session)"`
+	Size     int64  `cli-usage:"number of connections of the pool"`
+	Username string `cli-usage:"name of the user used to connect to the database"`
+	Zone     string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasConnectionPoolUpdateCmd) CmdAliases() []string { return nil }
+
+func (c *dbaasConnectionPoolUpdateCmd) CmdShort() string { return "Update DBAAS connection pool" }
+
+func (c *dbaasConnectionPoolUpdateCmd) CmdLong() string {
+	return `This command updates a PgBouncer connection pool of a PostgreSQL Database Service.`
+}
+
+func (c *dbaasConnectionPoolUpdateCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasConnectionPoolUpdateCmd) CmdRun(cmd *cobra.Command, _ []string) error {
+	var (
+		req     v3.UpdateDBAASPGConnectionPoolRequest
+		updated bool
+	)
+
+	if cmd.Flags().Changed(exocmd.MustCLICommandFlagName(c, &c.Database)) {
+		req.DatabaseName = v3.DBAASDatabaseName(c.Database)
+		updated = true
+	}
+
+	if cmd.Flags().Changed(exocmd.MustCLICommandFlagName(c, &c.Mode)) {
+		if err := dbaasValidateConnectionPoolMode(c.Mode); err != nil {
+			return err
+		}
+		req.Mode = v3.EnumPGPoolMode(c.Mode)
+		updated = true
+	}
+
+	if cmd.Flags().Changed(exocmd.MustCLICommandFlagName(c, &c.Size)) {
+		req.Size = v3.DBAASPGPoolSize(c.Size)
+		updated = true
+	}
+
+	if cmd.Flags().Changed(exocmd.MustCLICommandFlagName(c, &c.Username)) {
+		req.Username = v3.DBAASPGPoolUsername(c.Username)
+		updated = true
+	}
+
+	if !updated {
+		return fmt.Errorf("nothing to update")
+	}
+
+	ctx := exocmd.GContext
+	s, err := dbaasGetServicePG(ctx, c.Name, c.Zone)
+	if err != nil {
+		return err
+	}
+	if err := dbaasCheckConnectionPoolExists(s, c.Pool); err != nil {
+		return err
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	op, err := client.UpdateDBAASPGConnectionPool(ctx, c.Name, c.Pool, req)
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Updating DBaaS connection pool %q", c.Pool), func() {
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return c.OutputFunc(dbaasListConnectionPools(ctx, c.Name, c.Zone))
+	}
+
+	return nil
+}
+
+func dbaasCheckConnectionPoolExists(s *v3.DBAASServicePG, pool string) error {
+	for _, p := range s.ConnectionPools {
+		if string(p.Name) == pool {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("connection pool %q not found for service %q", pool, s.Name)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasConnectionPoolCmd, &dbaasConnectionPoolUpdateCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
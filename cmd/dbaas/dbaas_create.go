@@ -21,6 +21,7 @@ type dbaasServiceCreateCmd struct {
 	Plan string `cli-arg:"#"`
 	Name string `cli-arg:"#"`
 
+	HelpClickhouse bool `cli-usage:"show usage for flags specific to the clickhouse type"`
 	HelpKafka      bool `cli-usage:"show usage for flags specific to the kafka type"`
 	HelpOpensearch bool `cli-usage:"show usage for flags specific to the opensearch type"`
 	HelpMysql      bool `cli-usage:"show usage for flags specific to the mysql type"`
@@ -34,6 +35,13 @@ type dbaasServiceCreateCmd struct {
 	TerminationProtection bool   `cli-usage:"enable Database Service termination protection; set --termination-protection=false to disable"`
 	Zone                  string `cli-short:"z" cli-usage:"Database Service zone"`
 
+	// "clickhouse" type specific flags
+	ClickhouseForkFrom           string   `cli-flag:"clickhouse-fork-from" cli-usage:"name of a Database Service to fork from" cli-hidden:""`
+	ClickhouseIPFilter           []string `cli-flag:"clickhouse-ip-filter" cli-usage:"allow incoming connections from CIDR address block" cli-hidden:""`
+	ClickhouseRecoveryBackupName string   `cli-flag:"clickhouse-recovery-backup-name" cli-usage:"the name of the backup to restore when forking from a Database Service" cli-hidden:""`
+	ClickhouseSettings           string   `cli-flag:"clickhouse-settings" cli-usage:"ClickHouse configuration settings (JSON format)" cli-hidden:""`
+	ClickhouseVersion            string   `cli-flag:"clickhouse-version" cli-usage:"ClickHouse major version" cli-hidden:""`
+
 	// "grafana" type specific flags
 	GrafanaForkFrom string   `cli-flag:"grafana-fork-from" cli-usage:"name of a Database Service to fork from" cli-hidden:""`
 	GrafanaIPFilter []string `cli-flag:"grafana-ip-filter" cli-usage:"allow incoming connections from CIDR address block" cli-hidden:""`
@@ -146,6 +154,9 @@ Supported output template annotations: %s`,
 
 func (c *dbaasServiceCreateCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
 	switch {
+	case cmd.Flags().Changed("help-clickhouse"):
+		exocmd.CmdShowHelpFlags(cmd.Flags(), "clickhouse-")
+		os.Exit(0)
 	case cmd.Flags().Changed("help-grafana"):
 		exocmd.CmdShowHelpFlags(cmd.Flags(), "grafana-")
 		os.Exit(0)
@@ -185,6 +196,8 @@ func (c *dbaasServiceCreateCmd) CmdRun(cmd *cobra.Command, args []string) error
 	}
 
 	switch c.Type {
+	case "clickhouse":
+		return c.createClickhouse(cmd, args)
 	case "grafana":
 		return c.createGrafana(cmd, args)
 	case "kafka":
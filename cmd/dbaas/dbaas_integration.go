@@ -0,0 +1,170 @@
+package dbaas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/table"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+var dbaasIntegrationCmd = &cobra.Command{
+	Use:   "integration",
+	Short: "Manage Database Services integrations",
+	Long: `These commands manage the integrations between Database Services of the
+same organization (e.g. sending metrics to a Thanos service, logs to an
+OpenSearch service, or using a service as a Grafana datasource).
+
+Integrations with external endpoints are managed using the
+"exo dbaas external-integration" commands.`,
+}
+
+func init() {
+	dbaasCmd.AddCommand(dbaasIntegrationCmd)
+}
+
+type dbaasIntegrationShowOutput struct {
+	ID          string         `json:"id"`
+	Type        string         `json:"type"`
+	Source      string         `json:"source"`
+	Dest        string         `json:"dest"`
+	Description string         `json:"description"`
+	Status      string         `json:"status"`
+	Active      bool           `json:"active"`
+	Enabled     bool           `json:"enabled"`
+	Settings    map[string]any `json:"settings"`
+}
+
+func (o *dbaasIntegrationShowOutput) ToJSON() { output.JSON(o) }
+func (o *dbaasIntegrationShowOutput) ToText() { output.Text(o) }
+func (o *dbaasIntegrationShowOutput) ToTable() {
+	t := table.NewTable(os.Stdout)
+	t.SetHeader([]string{"Database Service Integration"})
+	defer t.Render()
+
+	t.Append([]string{"ID", o.ID})
+	t.Append([]string{"Type", o.Type})
+	t.Append([]string{"Source", o.Source})
+	t.Append([]string{"Destination", o.Dest})
+	t.Append([]string{"Description", o.Description})
+	t.Append([]string{"Status", o.Status})
+	t.Append([]string{"Active", strconv.FormatBool(o.Active)})
+	t.Append([]string{"Enabled", strconv.FormatBool(o.Enabled)})
+
+	settings := make([]string, 0, len(o.Settings))
+	for k, v := range o.Settings {
+		settings = append(settings, fmt.Sprintf("%s: %s", k, dbaasIntegrationSettingValue(v)))
+	}
+	sort.Strings(settings)
+	if len(settings) == 0 {
+		settings = append(settings, "n/a")
+	}
+	t.Append([]string{"Settings", strings.Join(settings, "\n")})
+}
+
+func dbaasIntegrationShowOutputFromIntegration(i *v3.DBAASIntegration) *dbaasIntegrationShowOutput {
+	return &dbaasIntegrationShowOutput{
+		ID:          i.ID.String(),
+		Type:        i.Type,
+		Source:      i.Source,
+		Dest:        i.Dest,
+		Description: i.Description,
+		Status:      i.Status,
+		Active:      i.ISActive != nil && *i.ISActive,
+		Enabled:     i.ISEnabled != nil && *i.ISEnabled,
+		Settings:    i.Settings,
+	}
+}
+
+// dbaasIntegrationSettingValue returns the textual representation of an
+// integration setting value.
+func dbaasIntegrationSettingValue(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(b)
+}
+
+// dbaasParseIntegrationSettings parses a list of KEY=VALUE integration
+// settings. Values are decoded as JSON when possible (e.g. numbers, booleans
+// or lists), and used as plain strings otherwise.
+func dbaasParseIntegrationSettings(settings []string) (map[string]any, error) {
+	res := make(map[string]any, len(settings))
+
+	for _, s := range settings {
+		k, v, ok := strings.Cut(s, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid setting %q, expected KEY=VALUE", s)
+		}
+
+		var value any
+		if err := json.Unmarshal([]byte(v), &value); err != nil {
+			value = v
+		}
+		res[k] = value
+	}
+
+	return res, nil
+}
+
+// dbaasValidateIntegrationSettings ensures that the settings specified are
+// supported by the integration type between services of the types specified.
+func dbaasValidateIntegrationSettings(
+	ctx context.Context,
+	client *v3.Client,
+	integrationType, sourceType, destType string,
+	settings map[string]any,
+) error {
+	if len(settings) == 0 {
+		return nil
+	}
+
+	res, err := client.ListDBAASIntegrationSettings(ctx, integrationType, sourceType, destType)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve %q integration settings: %w", integrationType, err)
+	}
+
+	supported := make([]string, 0)
+	if res.Settings != nil {
+		for k := range res.Settings.Properties {
+			supported = append(supported, k)
+		}
+	}
+	sort.Strings(supported)
+
+	for k := range settings {
+		if idx := sort.SearchStrings(supported, k); idx == len(supported) || supported[idx] != k {
+			if len(supported) == 0 {
+				return fmt.Errorf("%q integration from %s to %s doesn't support any setting", integrationType, sourceType, destType)
+			}
+			return fmt.Errorf("unsupported setting %q (supported settings: %s)", k, strings.Join(supported, ", "))
+		}
+	}
+
+	return nil
+}
+
+// dbaasGetServiceType returns the type of the Database Service specified.
+func dbaasGetServiceType(services []v3.DBAASServiceCommon, name string) (string, error) {
+	for _, s := range services {
+		if string(s.Name) == name {
+			return string(s.Type), nil
+		}
+	}
+
+	return "", fmt.Errorf("%q Database Service not found", name)
+}
@@ -0,0 +1,112 @@
+package dbaas
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasIntegrationCreateCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"create"`
+
+	Type   string `cli-arg:"#" cli-usage:"TYPE"`
+	Source string `cli-arg:"#" cli-usage:"SOURCE-SERVICE"`
+	Dest   string `cli-arg:"#" cli-usage:"DESTINATION-SERVICE"`
+
+	Settings []string `cli-flag:"setting" cli-usage:"integration setting, in KEY=VALUE format (can be repeated multiple times)"`
+	Zone     string   `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasIntegrationCreateCmd) CmdAliases() []string { return exocmd.GCreateAlias }
+
+func (c *dbaasIntegrationCreateCmd) CmdShort() string {
+	return "Create a Database Service integration"
+}
+
+func (c *dbaasIntegrationCreateCmd) CmdLong() string {
+	return `This command creates an integration between two Database Services.
+
+The integration types, the service types they can connect and their supported
+settings can be listed using "exo dbaas integration types".
+
+Example:
+
+    exo dbaas integration create datasource my-grafana my-thanos`
+}
+
+func (c *dbaasIntegrationCreateCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasIntegrationCreateCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+
+	settings, err := dbaasParseIntegrationSettings(c.Settings)
+	if err != nil {
+		return err
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	services, err := client.ListDBAASServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	sourceType, err := dbaasGetServiceType(services.DBAASServices, c.Source)
+	if err != nil {
+		return err
+	}
+	destType, err := dbaasGetServiceType(services.DBAASServices, c.Dest)
+	if err != nil {
+		return err
+	}
+
+	if err := dbaasValidateIntegrationSettings(ctx, client, c.Type, sourceType, destType, settings); err != nil {
+		return err
+	}
+
+	req := v3.CreateDBAASIntegrationRequest{
+		IntegrationType: v3.EnumIntegrationTypes(c.Type),
+		SourceService:   v3.DBAASServiceName(c.Source),
+		DestService:     v3.DBAASServiceName(c.Dest),
+	}
+	if len(settings) > 0 {
+		req.Settings = settings
+	}
+
+	op, err := client.CreateDBAASIntegration(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Creating %s integration from %q to %q...", c.Type, c.Source, c.Dest), func() {
+		op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet && op.Reference != nil {
+		return c.OutputFunc(dbaasShowIntegration(ctx, op.Reference.ID.String(), c.Zone))
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasIntegrationCmd, &dbaasIntegrationCreateCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,84 @@
+package dbaas
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasIntegrationDeleteCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"delete"`
+
+	IDs []string `cli-arg:"*" cli-usage:"ID"`
+
+	Force bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
+	Zone  string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasIntegrationDeleteCmd) CmdAliases() []string { return exocmd.GDeleteAlias }
+
+func (c *dbaasIntegrationDeleteCmd) CmdShort() string {
+	return "Delete Database Service integrations"
+}
+
+func (c *dbaasIntegrationDeleteCmd) CmdLong() string { return "" }
+
+func (c *dbaasIntegrationDeleteCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasIntegrationDeleteCmd) CmdRun(cmd *cobra.Command, _ []string) error {
+	if len(c.IDs) == 0 {
+		exocmd.CmdExitOnUsageError(cmd, "no integrations specified")
+	}
+
+	ctx := exocmd.GContext
+
+	integrationIDs := make([]v3.UUID, 0, len(c.IDs))
+	for _, id := range c.IDs {
+		integrationID, err := v3.ParseUUID(id)
+		if err != nil {
+			return fmt.Errorf("invalid integration ID %q: %w", id, err)
+		}
+		integrationIDs = append(integrationIDs, integrationID)
+	}
+
+	if !c.Force {
+		if !utils.AskQuestion(ctx, fmt.Sprintf("Are you sure you want to delete %d integration(s)?", len(integrationIDs))) {
+			return nil
+		}
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	fns := make([]func() error, 0, len(integrationIDs))
+	for _, id := range integrationIDs {
+		fns = append(fns, func() error {
+			op, err := client.DeleteDBAASIntegration(ctx, id)
+			if err != nil {
+				return fmt.Errorf("integration %s: %w", id, err)
+			}
+			_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+			return err
+		})
+	}
+
+	return utils.DecorateAsyncOperations("Deleting Database Service integration(s)...", fns...)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasIntegrationCmd, &dbaasIntegrationDeleteCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
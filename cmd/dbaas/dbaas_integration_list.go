@@ -0,0 +1,110 @@
+package dbaas
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasIntegrationListItemOutput struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Source  string `json:"source"`
+	Dest    string `json:"dest"`
+	Status  string `json:"status"`
+	Enabled bool   `json:"enabled"`
+}
+
+type dbaasIntegrationListOutput []dbaasIntegrationListItemOutput
+
+func (o *dbaasIntegrationListOutput) ToJSON()  { output.JSON(o) }
+func (o *dbaasIntegrationListOutput) ToText()  { output.Text(o) }
+func (o *dbaasIntegrationListOutput) ToTable() { output.Table(o) }
+
+type dbaasIntegrationListCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"list"`
+
+	Name string `cli-arg:"?" cli-usage:"SERVICE-NAME"`
+	Zone string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasIntegrationListCmd) CmdAliases() []string { return exocmd.GListAlias }
+
+func (c *dbaasIntegrationListCmd) CmdShort() string {
+	return "List Database Service integrations"
+}
+
+func (c *dbaasIntegrationListCmd) CmdLong() string {
+	return fmt.Sprintf(`This command lists the integrations between Database Services, optionally
+restricted to the integrations a Database Service is the source or the
+destination of.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&dbaasIntegrationListItemOutput{}), ", "))
+}
+
+func (c *dbaasIntegrationListCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasIntegrationListCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	res, err := client.ListDBAASServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	if c.Name != "" {
+		if _, err := dbaasGetServiceType(res.DBAASServices, c.Name); err != nil {
+			return err
+		}
+	}
+
+	// An integration is reported by both its source and destination
+	// services, hence the deduplication.
+	out := make(dbaasIntegrationListOutput, 0)
+	seen := make(map[v3.UUID]struct{})
+	for _, s := range res.DBAASServices {
+		for _, i := range s.Integrations {
+			if _, ok := seen[i.ID]; ok {
+				continue
+			}
+			if c.Name != "" && i.Source != c.Name && i.Dest != c.Name {
+				continue
+			}
+			seen[i.ID] = struct{}{}
+
+			out = append(out, dbaasIntegrationListItemOutput{
+				ID:      i.ID.String(),
+				Type:    i.Type,
+				Source:  i.Source,
+				Dest:    i.Dest,
+				Status:  i.Status,
+				Enabled: i.ISEnabled != nil && *i.ISEnabled,
+			})
+		}
+	}
+
+	return c.OutputFunc(&out, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasIntegrationCmd, &dbaasIntegrationListCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,67 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasIntegrationShowCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"show"`
+
+	ID   string `cli-arg:"#"`
+	Zone string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasIntegrationShowCmd) CmdAliases() []string { return exocmd.GShowAlias }
+
+func (c *dbaasIntegrationShowCmd) CmdShort() string {
+	return "Show a Database Service integration details"
+}
+
+func (c *dbaasIntegrationShowCmd) CmdLong() string {
+	return `This command shows a Database Service integration details, including its
+settings.`
+}
+
+func (c *dbaasIntegrationShowCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasIntegrationShowCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	return c.OutputFunc(dbaasShowIntegration(exocmd.GContext, c.ID, c.Zone))
+}
+
+func dbaasShowIntegration(ctx context.Context, id, zone string) (output.Outputter, error) {
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(zone))
+	if err != nil {
+		return nil, err
+	}
+
+	integrationID, err := v3.ParseUUID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid integration ID: %w", err)
+	}
+
+	integration, err := client.GetDBAASIntegration(ctx, integrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbaasIntegrationShowOutputFromIntegration(integration), nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasIntegrationCmd, &dbaasIntegrationShowCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,160 @@
+package dbaas
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/testutils"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testIntegrationID = "22222222-2222-2222-2222-222222222222"
+
+type integrationTestServer struct {
+	*httptest.Server
+
+	createReq *v3.CreateDBAASIntegrationRequest
+	updateReq *v3.UpdateDBAASIntegrationRequest
+}
+
+func setupIntegrationTestServer(t *testing.T) *integrationTestServer {
+	t.Helper()
+
+	ts := &integrationTestServer{}
+	mux := http.NewServeMux()
+
+	enabled := true
+	integration := v3.DBAASIntegration{
+		ID:        v3.UUID(testIntegrationID),
+		Type:      "datasource",
+		Source:    "grafana1",
+		Dest:      "thanos1",
+		Status:    "running",
+		ISEnabled: &enabled,
+		Settings:  map[string]any{"datasource-name": "thanos", "timeout": float64(30)},
+	}
+
+	mux.HandleFunc("/zone", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListZonesResponse{
+			Zones: []v3.Zone{{APIEndpoint: v3.Endpoint(ts.URL), Name: v3.ZoneName("test-zone")}},
+		})
+	})
+
+	mux.HandleFunc("/dbaas-service", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListDBAASServicesResponse{
+			DBAASServices: []v3.DBAASServiceCommon{
+				{Name: "grafana1", Type: "grafana", Integrations: []v3.DBAASIntegration{integration}},
+				{Name: "thanos1", Type: "thanos", Integrations: []v3.DBAASIntegration{integration}},
+				{Name: "pg1", Type: "pg"},
+			},
+		})
+	})
+
+	mux.HandleFunc("/dbaas-integration-settings/datasource/grafana/thanos", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListDBAASIntegrationSettingsResponse{
+			Settings: &v3.ListDBAASIntegrationSettingsResponseSettings{
+				Properties: map[string]any{"datasource-name": map[string]any{}, "timeout": map[string]any{}},
+			},
+		})
+	})
+
+	mux.HandleFunc("/dbaas-integration", func(w http.ResponseWriter, r *http.Request) {
+		ts.createReq = &v3.CreateDBAASIntegrationRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(ts.createReq))
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op-integration"), State: v3.OperationStateSuccess})
+	})
+
+	mux.HandleFunc("/dbaas-integration/"+testIntegrationID, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			ts.updateReq = &v3.UpdateDBAASIntegrationRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(ts.updateReq))
+			testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op-integration"), State: v3.OperationStateSuccess})
+			return
+		}
+		testutils.WriteJSON(t, w, http.StatusOK, integration)
+	})
+
+	mux.HandleFunc("/operation/", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op-integration"), State: v3.OperationStateSuccess})
+	})
+
+	ts.Server = httptest.NewServer(mux)
+	return ts
+}
+
+func TestDBAASIntegration(t *testing.T) {
+	ts := setupIntegrationTestServer(t)
+	defer ts.Close()
+	testutils.SetupV3Client(t, ts.URL)
+
+	var out output.Outputter
+	settings := exocmd.DefaultCLICmdSettings()
+	settings.OutputFunc = func(o output.Outputter, err error) error {
+		out = o
+		return err
+	}
+
+	err := runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasIntegrationListCmd{CliCommandSettings: settings})
+	}, "list", "thanos1", "--zone", "test-zone")
+	require.NoError(t, err)
+	assert.Equal(t, &dbaasIntegrationListOutput{{
+		ID:      testIntegrationID,
+		Type:    "datasource",
+		Source:  "grafana1",
+		Dest:    "thanos1",
+		Status:  "running",
+		Enabled: true,
+	}}, out)
+
+	err = runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasIntegrationListCmd{CliCommandSettings: settings})
+	}, "list", "pg1", "--zone", "test-zone")
+	require.NoError(t, err)
+	assert.Equal(t, &dbaasIntegrationListOutput{}, out)
+
+	err = runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasIntegrationCreateCmd{CliCommandSettings: settings})
+	}, "create", "datasource", "grafana1", "thanos1", "--setting", "timeout=60", "--zone", "test-zone")
+	require.NoError(t, err)
+	assert.Equal(t, &v3.CreateDBAASIntegrationRequest{
+		IntegrationType: v3.EnumIntegrationTypesDatasource,
+		SourceService:   "grafana1",
+		DestService:     "thanos1",
+		Settings:        map[string]any{"timeout": float64(60)},
+	}, ts.createReq)
+
+	err = runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasIntegrationCreateCmd{CliCommandSettings: settings})
+	}, "create", "datasource", "grafana1", "thanos1", "--setting", "bogus=1", "--zone", "test-zone")
+	assert.ErrorContains(t, err, `unsupported setting "bogus"`)
+
+	err = runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasIntegrationUpdateCmd{CliCommandSettings: settings})
+	}, "update", testIntegrationID, "--setting", "datasource-name=metrics", "--zone", "test-zone")
+	require.NoError(t, err)
+	assert.Equal(t, &v3.UpdateDBAASIntegrationRequest{
+		Settings: map[string]any{"datasource-name": "metrics", "timeout": float64(30)},
+	}, ts.updateReq)
+
+	err = runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasIntegrationUpdateCmd{CliCommandSettings: settings})
+	}, "update", testIntegrationID, "--zone", "test-zone")
+	assert.ErrorContains(t, err, "nothing to update")
+}
+
+func TestDBAASParseIntegrationSettings(t *testing.T) {
+	settings, err := dbaasParseIntegrationSettings([]string{"a=1", "b=true", "c=text", `d=["x"]`, "e="})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": float64(1), "b": true, "c": "text", "d": []any{"x"}, "e": ""}, settings)
+
+	_, err = dbaasParseIntegrationSettings([]string{"invalid"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,94 @@
+package dbaas
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasIntegrationTypeListItemOutput struct {
+	Type        string   `json:"type"`
+	SourceTypes []string `json:"source_types"`
+	DestTypes   []string `json:"dest_types"`
+	Settings    []string `json:"settings"`
+}
+
+type dbaasIntegrationTypeListOutput []dbaasIntegrationTypeListItemOutput
+
+func (o *dbaasIntegrationTypeListOutput) ToJSON()  { output.JSON(o) }
+func (o *dbaasIntegrationTypeListOutput) ToText()  { output.Text(o) }
+func (o *dbaasIntegrationTypeListOutput) ToTable() { output.Table(o) }
+
+type dbaasIntegrationTypesCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"types"`
+
+	Zone string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasIntegrationTypesCmd) CmdAliases() []string { return nil }
+
+func (c *dbaasIntegrationTypesCmd) CmdShort() string {
+	return "List Database Service integration types"
+}
+
+func (c *dbaasIntegrationTypesCmd) CmdLong() string {
+	return fmt.Sprintf(`This command lists the supported Database Service integration types, along
+with the service types they can connect and their supported settings.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&dbaasIntegrationTypeListItemOutput{}), ", "))
+}
+
+func (c *dbaasIntegrationTypesCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasIntegrationTypesCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	res, err := client.ListDBAASIntegrationTypes(ctx)
+	if err != nil {
+		return err
+	}
+
+	out := make(dbaasIntegrationTypeListOutput, 0, len(res.DBAASIntegrationTypes))
+	for _, t := range res.DBAASIntegrationTypes {
+		settings := make([]string, 0)
+		if t.Settings != nil {
+			for k := range t.Settings.Properties {
+				settings = append(settings, k)
+			}
+		}
+		sort.Strings(settings)
+
+		out = append(out, dbaasIntegrationTypeListItemOutput{
+			Type:        t.Type,
+			SourceTypes: t.SourceServiceTypes,
+			DestTypes:   t.DestServiceTypes,
+			Settings:    settings,
+		})
+	}
+
+	return c.OutputFunc(&out, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasIntegrationCmd, &dbaasIntegrationTypesCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,119 @@
+package dbaas
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasIntegrationUpdateCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"update"`
+
+	ID string `cli-arg:"#"`
+
+	Settings []string `cli-flag:"setting" cli-usage:"integration setting, in KEY=VALUE format (can be repeated multiple times)"`
+	Zone     string   `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasIntegrationUpdateCmd) CmdAliases() []string { return nil }
+
+func (c *dbaasIntegrationUpdateCmd) CmdShort() string {
+	return "Update a Database Service integration settings"
+}
+
+func (c *dbaasIntegrationUpdateCmd) CmdLong() string {
+	return `This command updates the settings of a Database Service integration. The
+settings not specified are left unchanged.`
+}
+
+func (c *dbaasIntegrationUpdateCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasIntegrationUpdateCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+
+	if len(c.Settings) == 0 {
+		return fmt.Errorf("nothing to update")
+	}
+
+	settings, err := dbaasParseIntegrationSettings(c.Settings)
+	if err != nil {
+		return err
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	integrationID, err := v3.ParseUUID(c.ID)
+	if err != nil {
+		return fmt.Errorf("invalid integration ID: %w", err)
+	}
+
+	integration, err := client.GetDBAASIntegration(ctx, integrationID)
+	if err != nil {
+		return err
+	}
+
+	services, err := client.ListDBAASServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	sourceType, err := dbaasGetServiceType(services.DBAASServices, integration.Source)
+	if err != nil {
+		return err
+	}
+	destType, err := dbaasGetServiceType(services.DBAASServices, integration.Dest)
+	if err != nil {
+		return err
+	}
+
+	if err := dbaasValidateIntegrationSettings(ctx, client, integration.Type, sourceType, destType, settings); err != nil {
+		return err
+	}
+
+	// The API replaces the whole integration settings: merge the settings
+	// specified into the current ones so the others are preserved.
+	merged := make(map[string]any, len(integration.Settings)+len(settings))
+	for k, v := range integration.Settings {
+		merged[k] = v
+	}
+	for k, v := range settings {
+		merged[k] = v
+	}
+
+	op, err := client.UpdateDBAASIntegration(ctx, integrationID, v3.UpdateDBAASIntegrationRequest{Settings: merged})
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Updating integration %s...", c.ID), func() {
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return c.OutputFunc(dbaasShowIntegration(ctx, c.ID, c.Zone))
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasIntegrationCmd, &dbaasIntegrationUpdateCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
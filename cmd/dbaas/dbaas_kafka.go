@@ -0,0 +1,102 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+var dbaasKafkaCmd = &cobra.Command{
+	Use:   "kafka",
+	Short: "Manage Kafka Database Services",
+}
+
+var dbaasKafkaACLCmd = &cobra.Command{
+	Use:   "acl",
+	Short: "Manage Kafka topic and Schema Registry ACLs",
+}
+
+func init() {
+	dbaasCmd.AddCommand(dbaasKafkaCmd)
+	dbaasKafkaCmd.AddCommand(dbaasKafkaACLCmd)
+}
+
+const (
+	dbaasKafkaACLTypeTopic          = "topic"
+	dbaasKafkaACLTypeSchemaRegistry = "schema-registry"
+)
+
+type dbaasKafkaACLListItemOutput struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Username   string `json:"username"`
+	Resource   string `json:"resource"`
+	Permission string `json:"permission"`
+}
+
+type dbaasKafkaACLListOutput []dbaasKafkaACLListItemOutput
+
+func (o *dbaasKafkaACLListOutput) ToJSON()  { output.JSON(o) }
+func (o *dbaasKafkaACLListOutput) ToText()  { output.Text(o) }
+func (o *dbaasKafkaACLListOutput) ToTable() { output.Table(o) }
+
+// dbaasGetKafkaACLs retrieves the ACLs of a Kafka Database Service.
+func dbaasGetKafkaACLs(ctx context.Context, name, zone string) (*v3.DBAASKafkaAcls, error) {
+	db, err := dbaasGetV3(ctx, name, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	if db.Type != "kafka" {
+		return nil, fmt.Errorf("%q is not a Kafka Database Service (type %q)", name, db.Type)
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(zone))
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetDBAASKafkaAclConfig(ctx, name)
+}
+
+func dbaasKafkaACLListFromACLs(acls *v3.DBAASKafkaAcls) dbaasKafkaACLListOutput {
+	out := make(dbaasKafkaACLListOutput, 0, len(acls.TopicAcl)+len(acls.SchemaRegistryAcl))
+
+	for _, acl := range acls.TopicAcl {
+		out = append(out, dbaasKafkaACLListItemOutput{
+			ID:         string(acl.ID),
+			Type:       dbaasKafkaACLTypeTopic,
+			Username:   acl.Username,
+			Resource:   acl.Topic,
+			Permission: string(acl.Permission),
+		})
+	}
+
+	for _, acl := range acls.SchemaRegistryAcl {
+		out = append(out, dbaasKafkaACLListItemOutput{
+			ID:         string(acl.ID),
+			Type:       dbaasKafkaACLTypeSchemaRegistry,
+			Username:   acl.Username,
+			Resource:   acl.Resource,
+			Permission: string(acl.Permission),
+		})
+	}
+
+	return out
+}
+
+func dbaasListKafkaACLs(ctx context.Context, name, zone string) (output.Outputter, error) {
+	acls, err := dbaasGetKafkaACLs(ctx, name, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	out := dbaasKafkaACLListFromACLs(acls)
+	return &out, nil
+}
@@ -0,0 +1,143 @@
+package dbaas
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasKafkaACLAddCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"add"`
+
+	Name string `cli-arg:"#"`
+
+	Permission            string `cli-usage:"ACL permission (topic: admin|read|readwrite|write, Schema Registry: read|write)"`
+	SchemaRegistryPattern string `cli-usage:"Schema Registry subject name or pattern the Schema Registry ACL applies to"`
+	TopicPattern          string `cli-usage:"topic name or pattern the topic ACL applies to"`
+	Username              string `cli-usage:"Kafka username or username pattern the ACL applies to"`
+	Zone                  string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasKafkaACLAddCmd) CmdAliases() []string { return []string{"create"} }
+
+func (c *dbaasKafkaACLAddCmd) CmdShort() string { return "Add a Kafka ACL" }
+
+func (c *dbaasKafkaACLAddCmd) CmdLong() string {
+	return `This command adds a topic ACL (--topic-pattern) or a Schema Registry ACL
+(--schema-registry-pattern) to a Kafka Database Service.
+
+Patterns support the "*" and "?" wildcards, e.g. --topic-pattern "orders.*".`
+}
+
+func (c *dbaasKafkaACLAddCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasKafkaACLAddCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	if c.Username == "" || c.Permission == "" {
+		return fmt.Errorf("both --%s and --%s must be specified",
+			exocmd.MustCLICommandFlagName(c, &c.Username),
+			exocmd.MustCLICommandFlagName(c, &c.Permission))
+	}
+
+	if (c.TopicPattern == "") == (c.SchemaRegistryPattern == "") {
+		return fmt.Errorf("exactly one of --%s and --%s must be specified",
+			exocmd.MustCLICommandFlagName(c, &c.TopicPattern),
+			exocmd.MustCLICommandFlagName(c, &c.SchemaRegistryPattern))
+	}
+
+	ctx := exocmd.GContext
+	if _, err := dbaasGetKafkaACLs(ctx, c.Name, c.Zone); err != nil {
+		return err
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	var op *v3.Operation
+	if c.TopicPattern != "" {
+		permission, err := dbaasKafkaTopicACLPermission(c.Permission)
+		if err != nil {
+			return err
+		}
+
+		op, err = client.CreateDBAASKafkaTopicAclConfig(ctx, c.Name, v3.DBAASKafkaTopicAclEntry{
+			Permission: permission,
+			Topic:      c.TopicPattern,
+			Username:   c.Username,
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		permission, err := dbaasKafkaSchemaRegistryACLPermission(c.Permission)
+		if err != nil {
+			return err
+		}
+
+		op, err = client.CreateDBAASKafkaSchemaRegistryAclConfig(ctx, c.Name, v3.DBAASKafkaSchemaRegistryAclEntry{
+			Permission: permission,
+			Resource:   c.SchemaRegistryPattern,
+			Username:   c.Username,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Adding Kafka ACL for user %q", c.Username), func() {
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return c.OutputFunc(dbaasListKafkaACLs(ctx, c.Name, c.Zone))
+	}
+
+	return nil
+}
+
+func dbaasKafkaTopicACLPermission(permission string) (v3.DBAASKafkaTopicAclEntryPermission, error) {
+	switch p := v3.DBAASKafkaTopicAclEntryPermission(strings.ToLower(permission)); p {
+	case v3.DBAASKafkaTopicAclEntryPermissionAdmin,
+		v3.DBAASKafkaTopicAclEntryPermissionRead,
+		v3.DBAASKafkaTopicAclEntryPermissionReadwrite,
+		v3.DBAASKafkaTopicAclEntryPermissionWrite:
+		return p, nil
+	}
+
+	return "", fmt.Errorf("invalid topic ACL permission %q, supported values: admin, read, readwrite, write", permission)
+}
+
+// dbaasKafkaSchemaRegistryACLPermission accepts both the short ("read",
+// "write") and the API ("schema_registry_read", "schema_registry_write")
+// forms of the Schema Registry ACL permissions.
+func dbaasKafkaSchemaRegistryACLPermission(permission string) (v3.DBAASKafkaSchemaRegistryAclEntryPermission, error) {
+	switch strings.TrimPrefix(strings.ToLower(permission), "schema_registry_") {
+	case "read":
+		return v3.DBAASKafkaSchemaRegistryAclEntryPermissionSchemaRegistryRead, nil
+	case "write":
+		return v3.DBAASKafkaSchemaRegistryAclEntryPermissionSchemaRegistryWrite, nil
+	}
+
+	return "", fmt.Errorf("invalid Schema Registry ACL permission %q, supported values: read, write", permission)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasKafkaACLCmd, &dbaasKafkaACLAddCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,99 @@
+package dbaas
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasKafkaACLDeleteCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"delete"`
+
+	Name  string `cli-arg:"#"`
+	ACLID string `cli-arg:"#" cli-usage:"ACL-ID"`
+
+	Force bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
+	Zone  string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasKafkaACLDeleteCmd) CmdAliases() []string { return exocmd.GDeleteAlias }
+
+func (c *dbaasKafkaACLDeleteCmd) CmdShort() string { return "Delete a Kafka ACL" }
+
+func (c *dbaasKafkaACLDeleteCmd) CmdLong() string {
+	return `This command deletes a topic or Schema Registry ACL of a Kafka Database Service.
+ACL IDs are displayed by the "exo dbaas kafka acl list" command.`
+}
+
+func (c *dbaasKafkaACLDeleteCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasKafkaACLDeleteCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	acls, err := dbaasGetKafkaACLs(ctx, c.Name, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	var acl *dbaasKafkaACLListItemOutput
+	for _, a := range dbaasKafkaACLListFromACLs(acls) {
+		if a.ID == c.ACLID {
+			acl = &a
+			break
+		}
+	}
+	if acl == nil {
+		return fmt.Errorf("ACL %q not found for service %q", c.ACLID, c.Name)
+	}
+
+	if !c.Force {
+		if !utils.AskQuestion(ctx, fmt.Sprintf(
+			"Are you sure you want to delete %s ACL %q (%s %s for %s)",
+			acl.Type, acl.ID, acl.Permission, acl.Resource, acl.Username)) {
+			return nil
+		}
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	var op *v3.Operation
+	if acl.Type == dbaasKafkaACLTypeTopic {
+		op, err = client.DeleteDBAASKafkaTopicAclConfig(ctx, c.Name, acl.ID)
+	} else {
+		op, err = client.DeleteDBAASKafkaSchemaRegistryAclConfig(ctx, c.Name, acl.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Deleting Kafka ACL %q", acl.ID), func() {
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return c.OutputFunc(dbaasListKafkaACLs(ctx, c.Name, c.Zone))
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasKafkaACLCmd, &dbaasKafkaACLDeleteCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,46 @@
+package dbaas
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/output"
+)
+
+type dbaasKafkaACLListCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"list"`
+
+	Name string `cli-arg:"#"`
+	Zone string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasKafkaACLListCmd) CmdAliases() []string { return exocmd.GListAlias }
+
+func (c *dbaasKafkaACLListCmd) CmdShort() string { return "List Kafka ACLs" }
+
+func (c *dbaasKafkaACLListCmd) CmdLong() string {
+	return fmt.Sprintf(`This command lists the topic and Schema Registry ACLs of a Kafka Database Service.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&dbaasKafkaACLListItemOutput{}), ", "))
+}
+
+func (c *dbaasKafkaACLListCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasKafkaACLListCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	return c.OutputFunc(dbaasListKafkaACLs(exocmd.GContext, c.Name, c.Zone))
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasKafkaACLCmd, &dbaasKafkaACLListCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,124 @@
+package dbaas
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/testutils"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type kafkaACLTestServer struct {
+	*httptest.Server
+
+	topicReq  *v3.DBAASKafkaTopicAclEntry
+	schemaReq *v3.DBAASKafkaSchemaRegistryAclEntry
+	deleted   []string
+}
+
+func setupKafkaACLTestServer(t *testing.T) *kafkaACLTestServer {
+	t.Helper()
+
+	ts := &kafkaACLTestServer{}
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/zone", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListZonesResponse{
+			Zones: []v3.Zone{{APIEndpoint: v3.Endpoint(ts.URL), Name: v3.ZoneName("test-zone")}},
+		})
+	})
+
+	mux.HandleFunc("/dbaas-service", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListDBAASServicesResponse{
+			DBAASServices: []v3.DBAASServiceCommon{{Name: "testkafka", Type: "kafka"}},
+		})
+	})
+
+	mux.HandleFunc("/dbaas-kafka/testkafka/acl-config", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.DBAASKafkaAcls{
+			TopicAcl: []v3.DBAASKafkaTopicAclEntry{
+				{ID: "acl-topic", Permission: "read", Topic: "orders.*", Username: "app"},
+			},
+			SchemaRegistryAcl: []v3.DBAASKafkaSchemaRegistryAclEntry{
+				{ID: "acl-schema", Permission: "schema_registry_read", Resource: "Subject:orders", Username: "app"},
+			},
+		})
+	})
+
+	mux.HandleFunc("/dbaas-kafka/testkafka/topic/acl-config", func(w http.ResponseWriter, r *http.Request) {
+		ts.topicReq = &v3.DBAASKafkaTopicAclEntry{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(ts.topicReq))
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op-acl"), State: v3.OperationStateSuccess})
+	})
+
+	mux.HandleFunc("/dbaas-kafka/testkafka/schema-registry/acl-config", func(w http.ResponseWriter, r *http.Request) {
+		ts.schemaReq = &v3.DBAASKafkaSchemaRegistryAclEntry{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(ts.schemaReq))
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op-acl"), State: v3.OperationStateSuccess})
+	})
+
+	mux.HandleFunc("/dbaas-kafka/testkafka/", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+		ts.deleted = append(ts.deleted, r.URL.Path)
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op-acl"), State: v3.OperationStateSuccess})
+	})
+
+	mux.HandleFunc("/operation/", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op-acl"), State: v3.OperationStateSuccess})
+	})
+
+	ts.Server = httptest.NewServer(mux)
+	return ts
+}
+
+func TestDBAASKafkaACLAdd(t *testing.T) {
+	ts := setupKafkaACLTestServer(t)
+	defer ts.Close()
+	testutils.SetupV3Client(t, ts.URL)
+
+	add := func(args ...string) error {
+		return runDBAASTestCommand(t, func(root *cobra.Command) error {
+			return exocmd.RegisterCLICommand(root, &dbaasKafkaACLAddCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings()})
+		}, append([]string{"add", "testkafka", "--zone", "test-zone"}, args...)...)
+	}
+
+	require.NoError(t, add("--username", "app", "--permission", "readwrite", "--topic-pattern", "orders.*"))
+	assert.Equal(t, &v3.DBAASKafkaTopicAclEntry{Permission: "readwrite", Topic: "orders.*", Username: "app"}, ts.topicReq)
+
+	require.NoError(t, add("--username", "app", "--permission", "write", "--schema-registry-pattern", "Subject:orders"))
+	assert.Equal(t, &v3.DBAASKafkaSchemaRegistryAclEntry{
+		Permission: v3.DBAASKafkaSchemaRegistryAclEntryPermissionSchemaRegistryWrite,
+		Resource:   "Subject:orders",
+		Username:   "app",
+	}, ts.schemaReq)
+
+	assert.ErrorContains(t, add("--permission", "read", "--topic-pattern", "x"), "must be specified")
+	assert.ErrorContains(t, add("--username", "app", "--permission", "read"), "exactly one of")
+	assert.ErrorContains(t, add("--username", "app", "--permission", "owner", "--topic-pattern", "x"), "invalid topic ACL permission")
+}
+
+func TestDBAASKafkaACLDelete(t *testing.T) {
+	ts := setupKafkaACLTestServer(t)
+	defer ts.Close()
+	testutils.SetupV3Client(t, ts.URL)
+
+	del := func(id string) error {
+		return runDBAASTestCommand(t, func(root *cobra.Command) error {
+			return exocmd.RegisterCLICommand(root, &dbaasKafkaACLDeleteCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings()})
+		}, "delete", "testkafka", id, "--force", "--zone", "test-zone")
+	}
+
+	require.NoError(t, del("acl-topic"))
+	require.NoError(t, del("acl-schema"))
+	assert.ErrorContains(t, del("acl-missing"), "not found")
+	assert.Equal(t, []string{
+		"/dbaas-kafka/testkafka/topic/acl-config/acl-topic",
+		"/dbaas-kafka/testkafka/schema-registry/acl-config/acl-schema",
+	}, ts.deleted)
+}
@@ -0,0 +1,101 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+var dbaasMaintenanceCmd = &cobra.Command{
+	Use:   "maintenance",
+	Short: "Manage Database Service maintenance",
+}
+
+func init() {
+	dbaasCmd.AddCommand(dbaasMaintenanceCmd)
+}
+
+// dbaasGetMaintenance returns the maintenance information (window and
+// pending updates) of a Database Service of the type specified.
+func dbaasGetMaintenance(ctx context.Context, client *v3.Client, name string, serviceType v3.DBAASServiceTypeName) (*v3.DBAASServiceMaintenance, error) {
+	switch serviceType {
+	case "clickhouse":
+		s, err := client.GetDBAASServiceClickhouse(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return s.Maintenance, nil
+	case "grafana":
+		s, err := client.GetDBAASServiceGrafana(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return s.Maintenance, nil
+	case "kafka":
+		s, err := client.GetDBAASServiceKafka(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return s.Maintenance, nil
+	case "mysql":
+		s, err := client.GetDBAASServiceMysql(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return s.Maintenance, nil
+	case "opensearch":
+		s, err := client.GetDBAASServiceOpensearch(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return s.Maintenance, nil
+	case "pg":
+		s, err := client.GetDBAASServicePG(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return s.Maintenance, nil
+	case "thanos":
+		s, err := client.GetDBAASServiceThanos(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return s.Maintenance, nil
+	case "valkey":
+		s, err := client.GetDBAASServiceValkey(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return s.Maintenance, nil
+	default:
+		return nil, fmt.Errorf("maintenance unsupported for service of type %q", serviceType)
+	}
+}
+
+// dbaasStartMaintenance starts the maintenance of a Database Service of the
+// type specified.
+func dbaasStartMaintenance(ctx context.Context, client *v3.Client, name string, serviceType v3.DBAASServiceTypeName) (*v3.Operation, error) {
+	switch serviceType {
+	case "clickhouse":
+		return client.StartDBAASClickhouseMaintenance(ctx, name)
+	case "grafana":
+		return client.StartDBAASGrafanaMaintenance(ctx, name)
+	case "kafka":
+		return client.StartDBAASKafkaMaintenance(ctx, name)
+	case "mysql":
+		return client.StartDBAASMysqlMaintenance(ctx, name)
+	case "opensearch":
+		return client.StartDBAASOpensearchMaintenance(ctx, name)
+	case "pg":
+		return client.StartDBAASPGMaintenance(ctx, name)
+	case "thanos":
+		return client.StartDBAASThanosMaintenance(ctx, name)
+	case "valkey":
+		return client.StartDBAASValkeyMaintenance(ctx, name)
+	default:
+		return nil, fmt.Errorf("maintenance unsupported for service of type %q", serviceType)
+	}
+}
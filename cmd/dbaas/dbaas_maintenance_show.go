@@ -0,0 +1,120 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasMaintenanceUpdateOutput struct {
+	Description string `json:"description"`
+	StartAfter  string `json:"start_after"`
+	StartAt     string `json:"start_at"`
+	Deadline    string `json:"deadline"`
+}
+
+type dbaasMaintenanceShowOutput struct {
+	Name    string                         `json:"name"`
+	Type    string                         `json:"type"`
+	State   string                         `json:"state"`
+	DOW     string                         `json:"dow" outputLabel:"Day Of Week"`
+	Time    string                         `json:"time" outputLabel:"Time (UTC)"`
+	Updates []dbaasMaintenanceUpdateOutput `json:"updates" outputLabel:"Pending Updates"`
+}
+
+func (o *dbaasMaintenanceShowOutput) ToJSON()  { output.JSON(o) }
+func (o *dbaasMaintenanceShowOutput) ToText()  { output.Text(o) }
+func (o *dbaasMaintenanceShowOutput) ToTable() { output.Table(o) }
+
+type dbaasMaintenanceShowCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"show"`
+
+	Name string `cli-arg:"#"`
+	Zone string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasMaintenanceShowCmd) CmdAliases() []string { return exocmd.GShowAlias }
+
+func (c *dbaasMaintenanceShowCmd) CmdShort() string {
+	return "Show Database Service maintenance information"
+}
+
+func (c *dbaasMaintenanceShowCmd) CmdLong() string {
+	return fmt.Sprintf(`This command shows the maintenance window and the updates waiting to be
+installed of a Database Service.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&dbaasMaintenanceShowOutput{}), ", "))
+}
+
+func (c *dbaasMaintenanceShowCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasMaintenanceShowCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	return c.OutputFunc(dbaasShowMaintenance(exocmd.GContext, c.Name, c.Zone))
+}
+
+func dbaasShowMaintenance(ctx context.Context, name, zone string) (output.Outputter, error) {
+	db, err := dbaasGetV3(ctx, name, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(zone))
+	if err != nil {
+		return nil, err
+	}
+
+	maintenance, err := dbaasGetMaintenance(ctx, client, name, db.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dbaasMaintenanceShowOutput{
+		Name:    name,
+		Type:    string(db.Type),
+		State:   string(db.State),
+		Updates: []dbaasMaintenanceUpdateOutput{},
+	}
+
+	if maintenance != nil {
+		out.DOW = string(maintenance.Dow)
+		out.Time = maintenance.Time
+
+		formatTime := func(t time.Time) string {
+			if t.IsZero() {
+				return ""
+			}
+			return t.Format(time.RFC3339)
+		}
+
+		for _, u := range maintenance.Updates {
+			out.Updates = append(out.Updates, dbaasMaintenanceUpdateOutput{
+				Description: u.Description,
+				StartAfter:  formatTime(u.StartAfter),
+				StartAt:     formatTime(u.StartAT),
+				Deadline:    formatTime(u.Deadline),
+			})
+		}
+	}
+
+	return out, nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasMaintenanceCmd, &dbaasMaintenanceShowCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
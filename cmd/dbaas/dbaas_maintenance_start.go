@@ -0,0 +1,202 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+// dbaasMaintenancePollInterval is the delay between two Database Service
+// state checks while waiting for a maintenance to complete.
+var dbaasMaintenancePollInterval = 10 * time.Second
+
+type dbaasMaintenanceStartCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"start"`
+
+	Name string `cli-arg:"#"`
+
+	Force       bool   `cli-short:"f" cli-usage:"don't prompt for confirmation"`
+	Wait        bool   `cli-usage:"wait for the maintenance to complete"`
+	WaitTimeout int64  `cli-usage:"maximum duration to wait for the maintenance to complete in seconds (0 to wait indefinitely)"`
+	Zone        string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasMaintenanceStartCmd) CmdAliases() []string { return nil }
+
+func (c *dbaasMaintenanceStartCmd) CmdShort() string {
+	return "Start Database Service maintenance"
+}
+
+func (c *dbaasMaintenanceStartCmd) CmdLong() string {
+	return `This command starts the maintenance of a Database Service, installing the
+pending updates immediately instead of waiting for the maintenance window.
+
+The pending updates can be listed using "exo dbaas maintenance show".
+
+With --wait, the command waits until the service is running again and some of
+the updates pending before the start have been installed, for at most
+--wait-timeout seconds. Updates that can't be installed yet may remain
+pending afterwards.`
+}
+
+func (c *dbaasMaintenanceStartCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasMaintenanceStartCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	if c.WaitTimeout < 0 {
+		return fmt.Errorf("invalid wait timeout %d", c.WaitTimeout)
+	}
+
+	ctx := exocmd.GContext
+	db, err := dbaasGetV3(ctx, c.Name, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	if !c.Force {
+		if !utils.AskQuestion(ctx, fmt.Sprintf(
+			"Are you sure you want to start the maintenance of Database Service %q? The service may be briefly unavailable.",
+			c.Name)) {
+			return nil
+		}
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	// The updates pending before the start are the ones the maintenance is
+	// expected to install.
+	var pending []v3.DBAASServiceUpdate
+	if c.Wait {
+		maintenance, err := dbaasGetMaintenance(ctx, client, c.Name, db.Type)
+		if err != nil {
+			return err
+		}
+		if maintenance != nil {
+			pending = maintenance.Updates
+		}
+	}
+
+	op, err := dbaasStartMaintenance(ctx, client, c.Name, db.Type)
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Starting Database Service %q maintenance...", c.Name), func() {
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if c.Wait {
+		waitCtx := ctx
+		if c.WaitTimeout > 0 {
+			var cancel context.CancelFunc
+			waitCtx, cancel = context.WithTimeout(ctx, time.Duration(c.WaitTimeout)*time.Second)
+			defer cancel()
+		}
+
+		utils.DecorateAsyncOperation(fmt.Sprintf("Waiting for Database Service %q maintenance to complete...", c.Name), func() {
+			err = dbaasWaitMaintenance(waitCtx, client, c.Name, db.Type, pending)
+		})
+		if err != nil {
+			if waitCtx.Err() == context.DeadlineExceeded {
+				return fmt.Errorf("maintenance of Database Service %q not completed after %ds", c.Name, c.WaitTimeout)
+			}
+			return err
+		}
+	}
+
+	if !globalstate.Quiet {
+		return c.OutputFunc(dbaasShowMaintenance(ctx, c.Name, c.Zone))
+	}
+
+	return nil
+}
+
+// dbaasWaitMaintenance waits for a Database Service maintenance to complete,
+// i.e. until the service is running again and some of the updates pending
+// before the maintenance started, if any, are no longer listed. Updates
+// deferred or not applicable may remain pending after a maintenance, so an
+// empty list of updates is not required.
+func dbaasWaitMaintenance(
+	ctx context.Context,
+	client *v3.Client,
+	name string,
+	serviceType v3.DBAASServiceTypeName,
+	pending []v3.DBAASServiceUpdate,
+) error {
+	ticker := time.NewTicker(dbaasMaintenancePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		services, err := client.ListDBAASServices(ctx)
+		if err != nil {
+			return err
+		}
+
+		i := slices.IndexFunc(services.DBAASServices, func(s v3.DBAASServiceCommon) bool {
+			return string(s.Name) == name
+		})
+		if i < 0 {
+			return fmt.Errorf("%q Database Service not found", name)
+		}
+		if services.DBAASServices[i].State != v3.EnumServiceStateRunning {
+			continue
+		}
+
+		if len(pending) == 0 {
+			return nil
+		}
+
+		maintenance, err := dbaasGetMaintenance(ctx, client, name, serviceType)
+		if err != nil {
+			return err
+		}
+		if maintenance == nil || dbaasUpdatesInstalled(pending, maintenance.Updates) {
+			return nil
+		}
+	}
+}
+
+// dbaasUpdatesInstalled returns true if some of the pending updates are no
+// longer listed in the current ones.
+func dbaasUpdatesInstalled(pending, current []v3.DBAASServiceUpdate) bool {
+	for _, p := range pending {
+		if !slices.ContainsFunc(current, func(u v3.DBAASServiceUpdate) bool {
+			return u.Description == p.Description && u.Deadline.Equal(p.Deadline)
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasMaintenanceCmd, &dbaasMaintenanceStartCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+		WaitTimeout:        3600,
+	}))
+}
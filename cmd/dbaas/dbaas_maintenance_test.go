@@ -0,0 +1,149 @@
+package dbaas
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/testutils"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type maintenanceTestServer struct {
+	*httptest.Server
+
+	started   bool
+	listPolls int
+
+	// deleted makes the service disappear once the maintenance started.
+	deleted bool
+	// deferred keeps the updates pending after the maintenance.
+	deferred bool
+}
+
+func setupMaintenanceTestServer(t *testing.T) *maintenanceTestServer {
+	t.Helper()
+
+	ts := &maintenanceTestServer{}
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/zone", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListZonesResponse{
+			Zones: []v3.Zone{{APIEndpoint: v3.Endpoint(ts.URL), Name: v3.ZoneName("test-zone")}},
+		})
+	})
+
+	mux.HandleFunc("/dbaas-service", func(w http.ResponseWriter, r *http.Request) {
+		state := v3.EnumServiceStateRunning
+		if ts.started {
+			ts.listPolls++
+			if ts.listPolls < 3 {
+				state = v3.EnumServiceStateRebalancing
+			}
+		}
+		services := []v3.DBAASServiceCommon{{Name: "testdb", Type: "mysql", State: state}}
+		if ts.started && ts.deleted {
+			services = nil
+		}
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListDBAASServicesResponse{DBAASServices: services})
+	})
+
+	mux.HandleFunc("/dbaas-mysql/testdb", func(w http.ResponseWriter, r *http.Request) {
+		maintenance := &v3.DBAASServiceMaintenance{Dow: "sunday", Time: "03:00:00"}
+		if !ts.started || ts.listPolls < 3 || ts.deferred {
+			maintenance.Updates = []v3.DBAASServiceUpdate{{
+				Description: "Update to the latest minor version",
+				StartAfter:  time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC),
+				Deadline:    time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC),
+			}}
+		}
+		testutils.WriteJSON(t, w, http.StatusOK, v3.DBAASServiceMysql{Name: "testdb", Maintenance: maintenance})
+	})
+
+	mux.HandleFunc("/dbaas-mysql/testdb/maintenance/start", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		ts.started = true
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op-maintenance"), State: v3.OperationStateSuccess})
+	})
+
+	mux.HandleFunc("/operation/", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op-maintenance"), State: v3.OperationStateSuccess})
+	})
+
+	ts.Server = httptest.NewServer(mux)
+	return ts
+}
+
+func TestDBAASMaintenance(t *testing.T) {
+	defer func(d time.Duration) { dbaasMaintenancePollInterval = d }(dbaasMaintenancePollInterval)
+	dbaasMaintenancePollInterval = time.Millisecond
+
+	ts := setupMaintenanceTestServer(t)
+	defer ts.Close()
+	testutils.SetupV3Client(t, ts.URL)
+
+	var out output.Outputter
+	settings := exocmd.DefaultCLICmdSettings()
+	settings.OutputFunc = func(o output.Outputter, err error) error {
+		out = o
+		return err
+	}
+
+	err := runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasMaintenanceShowCmd{CliCommandSettings: settings})
+	}, "show", "testdb", "--zone", "test-zone")
+	require.NoError(t, err)
+	assert.Equal(t, &dbaasMaintenanceShowOutput{
+		Name:  "testdb",
+		Type:  "mysql",
+		State: "running",
+		DOW:   "sunday",
+		Time:  "03:00:00",
+		Updates: []dbaasMaintenanceUpdateOutput{{
+			Description: "Update to the latest minor version",
+			StartAfter:  "2026-01-10T00:00:00Z",
+			Deadline:    "2026-02-10T00:00:00Z",
+		}},
+	}, out)
+
+	err = runDBAASTestCommand(t, func(root *cobra.Command) error {
+		return exocmd.RegisterCLICommand(root, &dbaasMaintenanceStartCmd{CliCommandSettings: settings})
+	}, "start", "testdb", "--force", "--wait", "--zone", "test-zone")
+	require.NoError(t, err)
+	assert.True(t, ts.started)
+	assert.Equal(t, 3, ts.listPolls)
+}
+
+func TestDBAASMaintenanceStartWait(t *testing.T) {
+	defer func(d time.Duration) { dbaasMaintenancePollInterval = d }(dbaasMaintenancePollInterval)
+	dbaasMaintenancePollInterval = time.Millisecond
+
+	start := func(t *testing.T, ts *maintenanceTestServer) error {
+		testutils.SetupV3Client(t, ts.URL)
+		return runDBAASTestCommand(t, func(root *cobra.Command) error {
+			return exocmd.RegisterCLICommand(root, &dbaasMaintenanceStartCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings()})
+		}, "start", "testdb", "--force", "--wait", "--wait-timeout", "1", "--zone", "test-zone")
+	}
+
+	t.Run("service deleted", func(t *testing.T) {
+		ts := setupMaintenanceTestServer(t)
+		defer ts.Close()
+		ts.deleted = true
+
+		assert.ErrorContains(t, start(t, ts), `"testdb" Database Service not found`)
+	})
+
+	t.Run("updates deferred", func(t *testing.T) {
+		ts := setupMaintenanceTestServer(t)
+		defer ts.Close()
+		ts.deferred = true
+
+		assert.ErrorContains(t, start(t, ts), "not completed after 1s")
+	})
+}
@@ -0,0 +1,178 @@
+package dbaas
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasMigrationCheckFindingOutput struct {
+	Severity string `json:"severity"`
+	Code     string `json:"code"`
+	Database string `json:"database"`
+}
+
+type dbaasMigrationCheckOutput struct {
+	Name      string                             `json:"name"`
+	Type      string                             `json:"type"`
+	SourceURI string                             `json:"source_uri" outputLabel:"Source URI"`
+	Method    string                             `json:"method"`
+	Success   bool                               `json:"success"`
+	Result    string                             `json:"result"`
+	Findings  []dbaasMigrationCheckFindingOutput `json:"findings"`
+}
+
+func (o *dbaasMigrationCheckOutput) ToJSON()  { output.JSON(o) }
+func (o *dbaasMigrationCheckOutput) ToText()  { output.Text(o) }
+func (o *dbaasMigrationCheckOutput) ToTable() { output.Table(o) }
+
+type dbaasMigrationCheckCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"check"`
+
+	Name string `cli-arg:"#"`
+
+	IgnoreDbs []string `cli-flag:"ignore-dbs" cli-usage:"database to ignore during the migration (MySQL only, can be repeated multiple times)"`
+	Method    string   `cli-usage:"migration method (dump|replication)"`
+	SourceURI string   `cli-flag:"source-uri" cli-usage:"URI of the source database, with admin credentials"`
+	Zone      string   `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasMigrationCheckCmd) CmdAliases() []string { return nil }
+
+func (c *dbaasMigrationCheckCmd) CmdShort() string {
+	return "Check whether a database can be migrated"
+}
+
+func (c *dbaasMigrationCheckCmd) CmdLong() string {
+	return fmt.Sprintf(`This command checks whether an external database can be migrated to the
+Database Service NAME (of type mysql or pg), and reports the issues found if
+any.
+
+The command exits with an error if the migration is not possible.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&dbaasMigrationCheckOutput{}), ", "))
+}
+
+func (c *dbaasMigrationCheckCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasMigrationCheckCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	if c.SourceURI == "" {
+		return fmt.Errorf("--source-uri must be specified")
+	}
+
+	ctx := exocmd.GContext
+	db, err := dbaasGetV3(ctx, c.Name, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	switch db.Type {
+	case "mysql", "pg":
+	default:
+		return fmt.Errorf("migrations not supported for database type %q", db.Type)
+	}
+
+	if len(c.IgnoreDbs) > 0 && db.Type != "mysql" {
+		return fmt.Errorf("--ignore-dbs is only supported for mysql databases")
+	}
+
+	req := v3.CreateDBAASTaskMigrationCheckRequest{
+		SourceServiceURI: c.SourceURI,
+		IgnoreDbs:        strings.Join(c.IgnoreDbs, ","),
+	}
+
+	if c.Method != "" {
+		switch m := v3.EnumMigrationMethod(c.Method); m {
+		case v3.EnumMigrationMethodDump, v3.EnumMigrationMethodReplication:
+			req.Method = m
+		default:
+			return fmt.Errorf("invalid migration method %q", c.Method)
+		}
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	op, err := client.CreateDBAASTaskMigrationCheck(ctx, c.Name, req)
+	if err != nil {
+		return err
+	}
+
+	op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	if err != nil {
+		return err
+	}
+	if op.Reference == nil {
+		return fmt.Errorf("migration check task reference missing from operation %s", op.ID)
+	}
+
+	task, err := dbaasWaitTask(ctx, client, c.Name, &v3.DBAASTask{ID: op.Reference.ID})
+	if err != nil {
+		return err
+	}
+
+	// The API only reports result codes: they are blocking issues when the
+	// check failed, and mere warnings otherwise.
+	severity := "warning"
+	if !*task.Success {
+		severity = "error"
+	}
+
+	out := &dbaasMigrationCheckOutput{
+		Name:      c.Name,
+		Type:      string(db.Type),
+		SourceURI: dbaasRedactURI(c.SourceURI),
+		Method:    string(req.Method),
+		Success:   *task.Success,
+		Result:    task.Result,
+		Findings:  make([]dbaasMigrationCheckFindingOutput, 0, len(task.ResultCodes)),
+	}
+	for _, rc := range task.ResultCodes {
+		out.Findings = append(out.Findings, dbaasMigrationCheckFindingOutput{
+			Severity: severity,
+			Code:     rc.Code,
+			Database: rc.Dbname,
+		})
+	}
+
+	if err := c.OutputFunc(out, nil); err != nil {
+		return err
+	}
+
+	if !out.Success {
+		return fmt.Errorf("migration to Database Service %q is not possible", c.Name)
+	}
+
+	return nil
+}
+
+// dbaasRedactURI masks the password of a database URI.
+func dbaasRedactURI(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return ""
+	}
+
+	return u.Redacted()
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasMigrationCmd, &dbaasMigrationCheckCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
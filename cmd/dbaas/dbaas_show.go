@@ -69,6 +69,7 @@ type dbServiceShowOutput struct {
 	UpdateDate            time.Time                       `json:"update_date"`
 	Zone                  string                          `json:"zone"`
 
+	Clickhouse *dbServiceClickhouseShowOutput `json:"clickhouse,omitempty"`
 	Grafana    *dbServiceGrafanaShowOutput    `json:"grafana,omitempty"`
 	Kafka      *dbServiceKafkaShowOutput      `json:"kafka,omitempty"`
 	Mysql      *dbServiceMysqlShowOutput      `json:"mysql,omitempty"`
@@ -106,6 +107,8 @@ func (o *dbServiceShowOutput) ToTable() {
 	}()})
 
 	switch {
+	case o.Clickhouse != nil:
+		formatDatabaseServiceClickhouseTable(t, o.Clickhouse)
 	case o.Grafana != nil:
 		formatDatabaseServiceGrafanaTable(t, o.Grafana)
 	case o.Kafka != nil:
@@ -147,6 +150,10 @@ func (c *dbaasServiceShowCmd) CmdLong() string {
 Supported output template annotations:
 
 * When showing a Database Service: %s
+  - .Clickhouse: %s
+    - .Clickhouse.Components[]: %s
+    - .Clickhouse.ConnectionInfo: %s
+    - .Clickhouse.Users[]: %s
   - .Kafka: %s
     - .Kafka.ACL[]: %s
     - .Kafka.AuthenticationMethods: %s
@@ -166,6 +173,10 @@ Supported output template annotations:
 
 * When showing a Database Service notifications: %s`,
 		strings.Join(output.TemplateAnnotations(&dbServiceShowOutput{}), ", "),
+		strings.Join(output.TemplateAnnotations(&dbServiceClickhouseShowOutput{}), ", "),
+		strings.Join(output.TemplateAnnotations(&dbServiceClickhouseComponentShowOutput{}), ", "),
+		strings.Join(output.TemplateAnnotations(&dbServiceClickhouseConnectionInfoOutput{}), ", "),
+		strings.Join(output.TemplateAnnotations(&dbServiceClickhouseUserShowOutput{}), ", "),
 		strings.Join(output.TemplateAnnotations(&dbServiceKafkaShowOutput{}), ", "),
 		strings.Join(output.TemplateAnnotations(&dbServiceKafkaACLShowOutput{}), ", "),
 		strings.Join(output.TemplateAnnotations(&dbServiceKafkaAuthenticationShowOutput{}), ", "),
@@ -199,6 +210,8 @@ func (c *dbaasServiceShowCmd) CmdRun(_ *cobra.Command, _ []string) error {
 	}
 
 	switch svc.Type {
+	case "clickhouse":
+		return c.OutputFunc(c.showDatabaseServiceClickhouse(ctx))
 	case "grafana":
 		return c.OutputFunc(c.showDatabaseServiceGrafana(ctx))
 	case "kafka":
@@ -0,0 +1,277 @@
+package dbaas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/go-wordwrap"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/table"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbServiceClickhouseComponentShowOutput struct {
+	Component string `json:"component"`
+	Host      string `json:"host"`
+	Port      int64  `json:"port"`
+	Route     string `json:"route"`
+	Usage     string `json:"usage"`
+}
+
+type dbServiceClickhouseUserShowOutput struct {
+	Required bool   `json:"required"`
+	Username string `json:"username,omitempty"`
+}
+
+type dbServiceClickhouseConnectionInfoOutput struct {
+	ArrowflightURI string   `json:"arrowflight_uri,omitempty"`
+	MysqlURI       string   `json:"mysql_uri,omitempty"`
+	URI            []string `json:"uri,omitempty"`
+}
+
+type dbServiceClickhousePrometheusURIOutput struct {
+	Host string `json:"host,omitempty"`
+	Port int64  `json:"port,omitempty"`
+}
+
+type dbServiceClickhouseShowOutput struct {
+	Components     []dbServiceClickhouseComponentShowOutput `json:"components"`
+	ConnectionInfo *dbServiceClickhouseConnectionInfoOutput `json:"connection_info,omitempty"`
+	IPFilter       []string                                 `json:"ip_filter"`
+	PrometheusURI  *dbServiceClickhousePrometheusURIOutput  `json:"prometheus_uri,omitempty"`
+	URI            string                                   `json:"uri"`
+	URIParams      map[string]interface{}                   `json:"uri_params"`
+	Users          []dbServiceClickhouseUserShowOutput      `json:"users"`
+	Version        string                                   `json:"version"`
+}
+
+var clickhouseSettings = []string{"clickhouse"}
+
+func formatDatabaseServiceClickhouseTable(t *table.Table, o *dbServiceClickhouseShowOutput) {
+	t.Append([]string{"Version", o.Version})
+	t.Append([]string{"URI", redactDatabaseServiceURI(o.URI)})
+	t.Append([]string{"IP Filter", strings.Join(o.IPFilter, ", ")})
+
+	if o.ConnectionInfo != nil {
+		t.Append([]string{"Connection Info", func() string {
+			buf := bytes.NewBuffer(nil)
+			ct := table.NewEmbeddedTable(buf)
+			ct.SetHeader([]string{" "})
+			for _, u := range o.ConnectionInfo.URI {
+				ct.Append([]string{"URI", redactDatabaseServiceURI(u)})
+			}
+			if o.ConnectionInfo.MysqlURI != "" {
+				ct.Append([]string{"MySQL URI", redactDatabaseServiceURI(o.ConnectionInfo.MysqlURI)})
+			}
+			if o.ConnectionInfo.ArrowflightURI != "" {
+				ct.Append([]string{"Arrow Flight URI", redactDatabaseServiceURI(o.ConnectionInfo.ArrowflightURI)})
+			}
+			ct.Render()
+			return buf.String()
+		}()})
+	}
+
+	if o.PrometheusURI != nil {
+		t.Append([]string{"Prometheus URI", fmt.Sprintf("%s:%d", o.PrometheusURI.Host, o.PrometheusURI.Port)})
+	}
+
+	t.Append([]string{"Components", func() string {
+		buf := bytes.NewBuffer(nil)
+		ct := table.NewEmbeddedTable(buf)
+		ct.SetHeader([]string{" "})
+		for _, c := range o.Components {
+			ct.Append([]string{
+				c.Component,
+				fmt.Sprintf("%s:%d", c.Host, c.Port),
+				"route:" + c.Route,
+				"usage:" + c.Usage,
+			})
+		}
+		ct.Render()
+
+		return buf.String()
+	}()})
+
+	t.Append([]string{"Users", func() string {
+		if len(o.Users) > 0 {
+			return strings.Join(
+				func() []string {
+					users := make([]string, len(o.Users))
+					for i := range o.Users {
+						users[i] = o.Users[i].Username
+						if o.Users[i].Required {
+							users[i] += " (required)"
+						}
+					}
+					return users
+				}(),
+				"\n")
+		}
+		return "n/a"
+	}()})
+}
+
+func (c *dbaasServiceShowCmd) showDatabaseServiceClickhouse(ctx context.Context) (output.Outputter, error) {
+
+	client, err := exocmd.SwitchClientZoneV3(
+		ctx,
+		globalstate.EgoscaleV3Client,
+		v3.ZoneName(c.Zone),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	databaseService, err := client.GetDBAASServiceClickhouse(ctx, c.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case c.ShowBackups:
+		out := make(dbServiceBackupListOutput, 0)
+		if databaseService.Backups != nil {
+			for _, b := range databaseService.Backups {
+				out = append(out, dbServiceBackupListItemOutput{
+					Date: b.BackupTime,
+					Name: b.BackupName,
+					Size: b.DataSize,
+				})
+			}
+		}
+		return &out, nil
+
+	case c.ShowNotifications:
+		out := make(dbServiceNotificationListOutput, 0)
+		if databaseService.Notifications != nil {
+			for _, n := range databaseService.Notifications {
+				out = append(out, dbServiceNotificationListItemOutput{
+					Level:   string(n.Level),
+					Message: wordwrap.WrapString(n.Message, 50),
+					Type:    string(n.Type),
+				})
+			}
+		}
+		return &out, nil
+
+	case c.ShowSettings != "":
+
+		switch c.ShowSettings {
+		case "clickhouse":
+			out, err := json.MarshalIndent(databaseService.ClickhouseSettings, "", "  ")
+			if err != nil {
+				return nil, fmt.Errorf("unable to marshal JSON: %w", err)
+			}
+			fmt.Println(string(out))
+		default:
+			return nil, fmt.Errorf(
+				"invalid settings value %q, expected one of: %s",
+				c.ShowSettings,
+				strings.Join(clickhouseSettings, ", "),
+			)
+		}
+
+		return nil, nil
+
+	case c.ShowURI:
+		fmt.Println(databaseService.URI)
+		return nil, nil
+	}
+
+	out := dbServiceShowOutput{
+		Zone:                  c.Zone,
+		Name:                  string(databaseService.Name),
+		Type:                  string(databaseService.Type),
+		Plan:                  databaseService.Plan,
+		CreationDate:          databaseService.CreatedAT,
+		Nodes:                 databaseService.NodeCount,
+		NodeCPUs:              databaseService.NodeCPUCount,
+		NodeMemory:            databaseService.NodeMemory,
+		UpdateDate:            databaseService.UpdatedAT,
+		DiskSize:              databaseService.DiskSize,
+		State:                 string(databaseService.State),
+		TerminationProtection: utils.DefaultBool(databaseService.TerminationProtection, false),
+
+		Maintenance: func() (v *dbServiceMaintenanceShowOutput) {
+			if databaseService.Maintenance != nil {
+				v = &dbServiceMaintenanceShowOutput{
+					DOW:  string(databaseService.Maintenance.Dow),
+					Time: databaseService.Maintenance.Time,
+				}
+			}
+			return
+		}(),
+
+		Clickhouse: &dbServiceClickhouseShowOutput{
+			Components: func() (v []dbServiceClickhouseComponentShowOutput) {
+				if databaseService.Components != nil {
+					for _, c := range databaseService.Components {
+						v = append(v, dbServiceClickhouseComponentShowOutput{
+							Component: c.Component,
+							Host:      c.Host,
+							Port:      c.Port,
+							Route:     string(c.Route),
+							Usage:     string(c.Usage),
+						})
+					}
+				}
+				return
+			}(),
+
+			ConnectionInfo: func() *dbServiceClickhouseConnectionInfoOutput {
+				if databaseService.ConnectionInfo != nil {
+					return &dbServiceClickhouseConnectionInfoOutput{
+						ArrowflightURI: databaseService.ConnectionInfo.ArrowflightURI,
+						MysqlURI:       databaseService.ConnectionInfo.MysqlURI,
+						URI:            databaseService.ConnectionInfo.URI,
+					}
+				}
+				return nil
+			}(),
+
+			IPFilter: func() (v []string) {
+				if databaseService.IPFilter != nil {
+					v = databaseService.IPFilter
+				}
+				return
+			}(),
+
+			PrometheusURI: func() *dbServiceClickhousePrometheusURIOutput {
+				if databaseService.PrometheusURI != nil {
+					return &dbServiceClickhousePrometheusURIOutput{
+						Host: databaseService.PrometheusURI.Host,
+						Port: databaseService.PrometheusURI.Port,
+					}
+				}
+				return nil
+			}(),
+
+			URI:       databaseService.URI,
+			URIParams: databaseService.URIParams,
+
+			Users: func() (v []dbServiceClickhouseUserShowOutput) {
+				if databaseService.Users != nil {
+					for _, u := range databaseService.Users {
+						v = append(v, dbServiceClickhouseUserShowOutput{
+							Required: utils.DefaultBool(u.Required, false),
+							Username: string(u.Username),
+						})
+					}
+				}
+				return
+			}(),
+
+			Version: databaseService.Version,
+		},
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,39 @@
+package dbaas
+
+import (
+	"context"
+	"time"
+
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+// dbaasTaskPollInterval is the delay between two DBaaS task status checks.
+var dbaasTaskPollInterval = 3 * time.Second
+
+// dbaasWaitTask polls a DBaaS service task until it completes, i.e. until
+// its success status is set, and returns its final state.
+func dbaasWaitTask(ctx context.Context, client *v3.Client, service string, task *v3.DBAASTask) (*v3.DBAASTask, error) {
+	spinner := utils.NewSpinner()
+	spinner.Start()
+	defer spinner.Stop()
+
+	ticker := time.NewTicker(dbaasTaskPollInterval)
+	defer ticker.Stop()
+
+	for task.Success == nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+
+		t, err := client.GetDBAASTask(ctx, service, task.ID)
+		if err != nil {
+			return nil, err
+		}
+		task = t
+	}
+
+	return task, nil
+}
@@ -138,12 +138,14 @@ Supported Database Service type settings:
 * %s
 * %s
 * %s
+* %s
 
 Supported output template annotations:
 
 * When showing a Database Service: %s
 
 * When listing Database Service plans: %s`,
+		strings.Join(clickhouseSettings, ", "),
 		strings.Join(grafanaSettings, ", "),
 		strings.Join(opensearchSettings, ", "),
 		strings.Join(kafkaSettings, ", "),
@@ -192,6 +194,26 @@ func (c *dbaasTypeShowCmd) CmdRun(_ *cobra.Command, _ []string) error { //nolint
 		var settings map[string]interface{}
 
 		switch c.Name {
+		case "clickhouse":
+			if !utils.IsInList(clickhouseSettings, c.ShowSettings) {
+				return fmt.Errorf(
+					"invalid settings value %q, expected one of: %s",
+					c.ShowSettings,
+					strings.Join(clickhouseSettings, ", "),
+				)
+			}
+
+			res, err := client.GetDBAASSettingsClickhouse(ctx)
+			if err != nil {
+				return err
+			}
+
+			if c.ShowSettings == "clickhouse" {
+				settings = res.Settings.Clickhouse.Properties
+			}
+
+			dbaasShowSettings(settings)
+
 		case "grafana":
 			if !utils.IsInList(grafanaSettings, c.ShowSettings) {
 				return fmt.Errorf(
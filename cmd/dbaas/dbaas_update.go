@@ -19,6 +19,7 @@ type dbaasServiceUpdateCmd struct {
 
 	Name string `cli-arg:"#"`
 
+	HelpClickhouse        bool   `cli-usage:"show usage for flags specific to the clickhouse type"`
 	HelpGrafana           bool   `cli-usage:"show usage for flags specific to the grafana type"`
 	HelpKafka             bool   `cli-usage:"show usage for flags specific to the kafka type"`
 	HelpOpensearch        bool   `cli-usage:"show usage for flags specific to the opensearch type"`
@@ -33,6 +34,10 @@ type dbaasServiceUpdateCmd struct {
 	Zone                  string `cli-short:"z" cli-usage:"Database Service zone"`
 	Force                 bool   `cli-short:"f" cli-usage:"don't prompt for confirmation before updating"`
 
+	// "clickhouse" type specific flags
+	ClickhouseIPFilter []string `cli-flag:"clickhouse-ip-filter" cli-usage:"allow incoming connections from CIDR address block" cli-hidden:""`
+	ClickhouseSettings string   `cli-flag:"clickhouse-settings" cli-usage:"ClickHouse configuration settings (JSON format)" cli-hidden:""`
+
 	// "grafana" type specific flags
 	GrafanaIPFilter []string `cli-flag:"grafana-ip-filter" cli-usage:"allow incoming connections from CIDR address block" cli-hidden:""`
 	GrafanaSettings string   `cli-flag:"grafana-settings" cli-usage:"MySQL configuration settings (JSON format)" cli-hidden:""`
@@ -129,6 +134,9 @@ Supported output template annotations: %s`,
 
 func (c *dbaasServiceUpdateCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
 	switch {
+	case cmd.Flags().Changed("help-clickhouse"):
+		exocmd.CmdShowHelpFlags(cmd.Flags(), "clickhouse-")
+		os.Exit(0)
 	case cmd.Flags().Changed("help-grafana"):
 		exocmd.CmdShowHelpFlags(cmd.Flags(), "grafana-")
 		os.Exit(0)
@@ -182,6 +190,8 @@ func (c *dbaasServiceUpdateCmd) CmdRun(cmd *cobra.Command, args []string) error
 	dbType := db.Type
 
 	switch dbType {
+	case "clickhouse":
+		return c.updateClickhouse(cmd, args)
 	case "grafana":
 		return c.updateGrafana(cmd, args)
 	case "kafka":
@@ -0,0 +1,126 @@
+package dbaas
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasUpgradeCheckOutput struct {
+	Name           string   `json:"name"`
+	CurrentVersion string   `json:"current_version"`
+	TargetVersion  string   `json:"target_version"`
+	Success        bool     `json:"success"`
+	Result         string   `json:"result"`
+	Blockers       []string `json:"blockers"`
+}
+
+func (o *dbaasUpgradeCheckOutput) ToJSON()  { output.JSON(o) }
+func (o *dbaasUpgradeCheckOutput) ToText()  { output.Text(o) }
+func (o *dbaasUpgradeCheckOutput) ToTable() { output.Table(o) }
+
+type dbaasUpgradeCheckCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"upgrade-check"`
+
+	Name string `cli-arg:"#"`
+
+	TargetVersion string `cli-usage:"PostgreSQL major version to upgrade to"`
+	Zone          string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasUpgradeCheckCmd) CmdAliases() []string { return nil }
+
+func (c *dbaasUpgradeCheckCmd) CmdShort() string {
+	return "Check whether a Database Service can be upgraded"
+}
+
+func (c *dbaasUpgradeCheckCmd) CmdLong() string {
+	return fmt.Sprintf(`This command checks whether a PostgreSQL Database Service can be upgraded
+to a newer major version, and reports the issues blocking the upgrade if any.
+
+The command exits with an error if the upgrade is not possible.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&dbaasUpgradeCheckOutput{}), ", "))
+}
+
+func (c *dbaasUpgradeCheckCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasUpgradeCheckCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	if c.TargetVersion == "" {
+		return fmt.Errorf("--target-version must be specified")
+	}
+
+	ctx := exocmd.GContext
+	s, err := dbaasGetServicePG(ctx, c.Name, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	task, err := client.CreateDBAASPGUpgradeCheck(ctx, c.Name, v3.CreateDBAASPGUpgradeCheckRequest{
+		TargetVersion: v3.DBAASPGTargetVersions(c.TargetVersion),
+	})
+	if err != nil {
+		return err
+	}
+
+	task, err = dbaasWaitTask(ctx, client, c.Name, task)
+	if err != nil {
+		return err
+	}
+
+	out := &dbaasUpgradeCheckOutput{
+		Name:           c.Name,
+		CurrentVersion: s.Version,
+		TargetVersion:  c.TargetVersion,
+		Success:        *task.Success,
+		Result:         task.Result,
+		Blockers:       dbaasTaskResultCodes(task),
+	}
+
+	if err := c.OutputFunc(out, nil); err != nil {
+		return err
+	}
+
+	if !out.Success {
+		return fmt.Errorf("upgrade of %q to PostgreSQL %s is not possible", c.Name, c.TargetVersion)
+	}
+
+	return nil
+}
+
+// dbaasTaskResultCodes formats the result codes of a DBaaS task.
+func dbaasTaskResultCodes(task *v3.DBAASTask) []string {
+	codes := make([]string, 0, len(task.ResultCodes))
+	for _, rc := range task.ResultCodes {
+		if rc.Dbname != "" {
+			codes = append(codes, fmt.Sprintf("%s (database %s)", rc.Code, rc.Dbname))
+			continue
+		}
+		codes = append(codes, rc.Code)
+	}
+
+	return codes
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasCmd, &dbaasUpgradeCheckCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
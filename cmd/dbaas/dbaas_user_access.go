@@ -0,0 +1,158 @@
+package dbaas
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/table"
+)
+
+var dbaasUserAccessCmd = &cobra.Command{
+	Use:   "access",
+	Short: "Manage DBaaS users access control",
+}
+
+func init() {
+	dbaasUserCmd.AddCommand(dbaasUserAccessCmd)
+}
+
+type dbaasOpensearchRule struct {
+	Index      string `json:"index" yaml:"index"`
+	Permission string `json:"permission" yaml:"permission"`
+}
+
+// dbaasUserAccessDocument represents the access control settings of a
+// Database Service user. Only the fields relevant to the service type are
+// set: rules and ACL toggles for opensearch, key/channel patterns, commands
+// and categories for valkey, replication permission for pg.
+type dbaasUserAccessDocument struct {
+	// "opensearch" type specific fields
+	ACLEnabled         *bool                 `json:"acl_enabled,omitempty" yaml:"acl_enabled,omitempty"`
+	ExtendedACLEnabled *bool                 `json:"extended_acl_enabled,omitempty" yaml:"extended_acl_enabled,omitempty"`
+	Rules              []dbaasOpensearchRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+
+	// "valkey" type specific fields
+	Categories []string `json:"categories,omitempty" yaml:"categories,omitempty"`
+	Channels   []string `json:"channels,omitempty" yaml:"channels,omitempty"`
+	Commands   []string `json:"commands,omitempty" yaml:"commands,omitempty"`
+	Keys       []string `json:"keys,omitempty" yaml:"keys,omitempty"`
+
+	// "pg" type specific fields
+	AllowReplication *bool `json:"allow_replication,omitempty" yaml:"allow_replication,omitempty"`
+}
+
+// unsupportedFields returns the names of the document fields set which
+// don't apply to the service type specified.
+func (d *dbaasUserAccessDocument) unsupportedFields(serviceType string) []string {
+	fields := map[string][]string{}
+	if d.ACLEnabled != nil {
+		fields["opensearch"] = append(fields["opensearch"], "acl_enabled")
+	}
+	if d.ExtendedACLEnabled != nil {
+		fields["opensearch"] = append(fields["opensearch"], "extended_acl_enabled")
+	}
+	if d.Rules != nil {
+		fields["opensearch"] = append(fields["opensearch"], "rules")
+	}
+	if d.Categories != nil {
+		fields["valkey"] = append(fields["valkey"], "categories")
+	}
+	if d.Channels != nil {
+		fields["valkey"] = append(fields["valkey"], "channels")
+	}
+	if d.Commands != nil {
+		fields["valkey"] = append(fields["valkey"], "commands")
+	}
+	if d.Keys != nil {
+		fields["valkey"] = append(fields["valkey"], "keys")
+	}
+	if d.AllowReplication != nil {
+		fields["pg"] = append(fields["pg"], "allow_replication")
+	}
+
+	var unsupported []string
+	for _, t := range []string{"opensearch", "pg", "valkey"} {
+		if t != serviceType {
+			unsupported = append(unsupported, fields[t]...)
+		}
+	}
+
+	return unsupported
+}
+
+// dbaasReadUserAccessDocument reads a user access control document in JSON
+// or YAML format from the file specified, or from the standard input if
+// path is "-".
+func dbaasReadUserAccessDocument(path string) (*dbaasUserAccessDocument, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read access control document: %w", err)
+	}
+
+	// JSON being a subset of YAML, a single decoder handles both formats.
+	var doc dbaasUserAccessDocument
+	if err := yaml.UnmarshalStrict(data, &doc); err != nil {
+		return nil, fmt.Errorf("unable to parse access control document: %w", err)
+	}
+
+	return &doc, nil
+}
+
+type dbaasUserAccessShowOutput struct {
+	Username string `json:"username"`
+	Type     string `json:"type"`
+
+	dbaasUserAccessDocument
+}
+
+func (o *dbaasUserAccessShowOutput) ToJSON() { output.JSON(o) }
+func (o *dbaasUserAccessShowOutput) ToText() { output.Text(o) }
+
+func (o *dbaasUserAccessShowOutput) ToTable() {
+	t := table.NewTable(os.Stdout)
+	t.SetHeader([]string{"Service User Access"})
+	defer t.Render()
+
+	t.Append([]string{"Username", o.Username})
+	t.Append([]string{"Type", o.Type})
+
+	formatBool := func(label string, v *bool) {
+		if v != nil {
+			t.Append([]string{label, strconv.FormatBool(*v)})
+		}
+	}
+
+	switch o.Type {
+	case "opensearch":
+		formatBool("ACL Enabled", o.ACLEnabled)
+		formatBool("Extended ACL Enabled", o.ExtendedACLEnabled)
+		rules := make([]string, 0, len(o.Rules))
+		for _, r := range o.Rules {
+			rules = append(rules, fmt.Sprintf("%s: %s", r.Index, r.Permission))
+		}
+		t.Append([]string{"Rules", strings.Join(rules, "\n")})
+	case "valkey":
+		t.Append([]string{"Categories", strings.Join(o.Categories, " ")})
+		t.Append([]string{"Channels", strings.Join(o.Channels, " ")})
+		t.Append([]string{"Commands", strings.Join(o.Commands, " ")})
+		t.Append([]string{"Keys", strings.Join(o.Keys, " ")})
+	case "pg":
+		formatBool("Allow Replication", o.AllowReplication)
+	}
+}
@@ -0,0 +1,141 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+func (c *dbaasUserAccessShowCmd) showOpensearch(ctx context.Context) (output.Outputter, error) {
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := client.GetDBAASServiceOpensearch(ctx, c.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *v3.DBAASServiceOpensearchUsers
+	for i := range s.Users {
+		if s.Users[i].Username == c.Username {
+			user = &s.Users[i]
+			break
+		}
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user %q not found for service %q", c.Username, c.Name)
+	}
+
+	acl, err := client.GetDBAASOpensearchAclConfig(ctx, c.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dbaasUserAccessShowOutput{
+		Username: c.Username,
+		Type:     "opensearch",
+		dbaasUserAccessDocument: dbaasUserAccessDocument{
+			ACLEnabled:         acl.AclEnabled,
+			ExtendedACLEnabled: acl.ExtendedAclEnabled,
+			Rules:              []dbaasOpensearchRule{},
+		},
+	}
+
+	for _, a := range acl.Acls {
+		if string(a.Username) != c.Username {
+			continue
+		}
+		for _, r := range a.Rules {
+			out.Rules = append(out.Rules, dbaasOpensearchRule{Index: r.Index, Permission: string(r.Permission)})
+		}
+	}
+
+	return out, nil
+}
+
+func (c *dbaasUserAccessUpdateCmd) updateOpensearch(ctx context.Context, doc *dbaasUserAccessDocument) error {
+	if doc.ACLEnabled == nil && doc.ExtendedACLEnabled == nil && doc.Rules == nil {
+		return fmt.Errorf("nothing to update")
+	}
+
+	rules := make([]v3.DBAASOpensearchAclConfigAclsRules, 0, len(doc.Rules))
+	for _, r := range doc.Rules {
+		permission := v3.EnumOpensearchRulePermission(r.Permission)
+		switch permission {
+		case v3.EnumOpensearchRulePermissionAdmin,
+			v3.EnumOpensearchRulePermissionDeny,
+			v3.EnumOpensearchRulePermissionRead,
+			v3.EnumOpensearchRulePermissionReadwrite,
+			v3.EnumOpensearchRulePermissionWrite:
+		default:
+			return fmt.Errorf("invalid permission %q for index %q, supported values: admin, deny, read, readwrite, write", r.Permission, r.Index)
+		}
+		rules = append(rules, v3.DBAASOpensearchAclConfigAclsRules{Index: r.Index, Permission: permission})
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	// Validate the user existence before altering the service ACL config.
+	if _, err := (&dbaasUserAccessShowCmd{Name: c.Name, Username: c.Username, Zone: c.Zone}).showOpensearch(ctx); err != nil {
+		return err
+	}
+
+	acl, err := client.GetDBAASOpensearchAclConfig(ctx, c.Name)
+	if err != nil {
+		return err
+	}
+
+	if doc.ACLEnabled != nil {
+		acl.AclEnabled = doc.ACLEnabled
+	}
+	if doc.ExtendedACLEnabled != nil {
+		acl.ExtendedAclEnabled = doc.ExtendedACLEnabled
+	}
+	if doc.Rules != nil {
+		acls := make([]v3.DBAASOpensearchAclConfigAcls, 0, len(acl.Acls)+1)
+		for _, a := range acl.Acls {
+			if string(a.Username) != c.Username {
+				acls = append(acls, a)
+			}
+		}
+		if len(rules) > 0 {
+			acls = append(acls, v3.DBAASOpensearchAclConfigAcls{
+				Username: v3.DBAASUserUsername(c.Username),
+				Rules:    rules,
+			})
+		}
+		acl.Acls = acls
+	}
+
+	op, err := client.UpdateDBAASOpensearchAclConfig(ctx, c.Name, *acl)
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Updating DBaaS user %q access control", c.Username), func() {
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return c.OutputFunc((&dbaasUserAccessShowCmd{
+			Name:     c.Name,
+			Zone:     c.Zone,
+			Username: c.Username,
+		}).showOpensearch(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+func (c *dbaasUserAccessShowCmd) showPG(ctx context.Context) (output.Outputter, error) {
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := client.GetDBAASServicePG(ctx, c.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range s.Users {
+		if u.Username == c.Username {
+			allowReplication := utils.DefaultBool(u.AllowReplication, false)
+			return &dbaasUserAccessShowOutput{
+				Username: c.Username,
+				Type:     "pg",
+				dbaasUserAccessDocument: dbaasUserAccessDocument{
+					AllowReplication: &allowReplication,
+				},
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("user %q not found for service %q", c.Username, c.Name)
+}
+
+func (c *dbaasUserAccessUpdateCmd) updatePG(ctx context.Context, doc *dbaasUserAccessDocument) error {
+	if doc.AllowReplication == nil {
+		return fmt.Errorf("nothing to update")
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	if _, err := (&dbaasUserAccessShowCmd{Name: c.Name, Username: c.Username, Zone: c.Zone}).showPG(ctx); err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Updating DBaaS user %q access control", c.Username), func() {
+		_, err = client.UpdateDBAASPostgresAllowReplication(ctx, c.Name, c.Username, v3.UpdateDBAASPostgresAllowReplicationRequest{
+			AllowReplication: doc.AllowReplication,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return c.OutputFunc((&dbaasUserAccessShowCmd{
+			Name:     c.Name,
+			Zone:     c.Zone,
+			Username: c.Username,
+		}).showPG(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package dbaas
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+)
+
+type dbaasUserAccessShowCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"show"`
+
+	Name     string `cli-arg:"#"`
+	Username string `cli-arg:"#"`
+	Zone     string `cli-short:"z" cli-usage:"Database Service zone"`
+}
+
+func (c *dbaasUserAccessShowCmd) CmdAliases() []string { return nil }
+
+func (c *dbaasUserAccessShowCmd) CmdShort() string { return "Show the access control of a user" }
+
+func (c *dbaasUserAccessShowCmd) CmdLong() string {
+	return `This command shows the access control settings of a DBAAS user, for services
+of type opensearch (index rules), valkey (keys, channels, commands and categories)
+and pg (replication permission).
+
+The JSON output (-O json) can be edited and used as input document of the
+"exo dbaas user access update" command.`
+}
+
+func (c *dbaasUserAccessShowCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasUserAccessShowCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	db, err := dbaasGetV3(ctx, c.Name, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	switch db.Type {
+	case "opensearch":
+		return c.OutputFunc(c.showOpensearch(ctx))
+	case "valkey":
+		return c.OutputFunc(c.showValkey(ctx))
+	case "pg":
+		return c.OutputFunc(c.showPG(ctx))
+	default:
+		return fmt.Errorf("user access control unsupported for service of type %q", db.Type)
+	}
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasUserAccessCmd, &dbaasUserAccessShowCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,145 @@
+package dbaas
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/testutils"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBAASReadUserAccessDocument(t *testing.T) {
+	dir := t.TempDir()
+
+	yamlDoc := filepath.Join(dir, "access.yaml")
+	require.NoError(t, os.WriteFile(yamlDoc, []byte(`
+acl_enabled: true
+rules:
+  - index: "logs-*"
+    permission: read
+`), 0o600))
+
+	doc, err := dbaasReadUserAccessDocument(yamlDoc)
+	require.NoError(t, err)
+	require.NotNil(t, doc.ACLEnabled)
+	assert.True(t, *doc.ACLEnabled)
+	assert.Equal(t, []dbaasOpensearchRule{{Index: "logs-*", Permission: "read"}}, doc.Rules)
+	assert.Empty(t, doc.unsupportedFields("opensearch"))
+	assert.Equal(t, []string{"acl_enabled", "rules"}, doc.unsupportedFields("valkey"))
+
+	jsonDoc := filepath.Join(dir, "access.json")
+	require.NoError(t, os.WriteFile(jsonDoc, []byte(`{"keys": ["cache:*"], "commands": ["+get"]}`), 0o600))
+
+	doc, err = dbaasReadUserAccessDocument(jsonDoc)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cache:*"}, doc.Keys)
+	assert.Equal(t, []string{"+get"}, doc.Commands)
+
+	badDoc := filepath.Join(dir, "bad.yaml")
+	require.NoError(t, os.WriteFile(badDoc, []byte("unknown_field: 1\n"), 0o600))
+
+	_, err = dbaasReadUserAccessDocument(badDoc)
+	assert.Error(t, err)
+}
+
+func TestDBAASUserAccessUpdate(t *testing.T) {
+	var (
+		gotOpensearch v3.DBAASOpensearchAclConfig
+		gotValkey     v3.UpdateDBAASValkeyUserAccessControlRequest
+	)
+
+	var ts *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zone", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListZonesResponse{
+			Zones: []v3.Zone{{APIEndpoint: v3.Endpoint(ts.URL), Name: v3.ZoneName("test-zone")}},
+		})
+	})
+	mux.HandleFunc("/dbaas-service", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListDBAASServicesResponse{
+			DBAASServices: []v3.DBAASServiceCommon{
+				{Name: "testos", Type: "opensearch"},
+				{Name: "testvk", Type: "valkey"},
+			},
+		})
+	})
+	mux.HandleFunc("/dbaas-opensearch/testos", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.DBAASServiceOpensearch{
+			Users: []v3.DBAASServiceOpensearchUsers{{Username: "app"}, {Username: "other"}},
+		})
+	})
+	mux.HandleFunc("/dbaas-opensearch/testos/acl-config", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&gotOpensearch))
+			testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op"), State: v3.OperationStateSuccess})
+			return
+		}
+		testutils.WriteJSON(t, w, http.StatusOK, v3.DBAASOpensearchAclConfig{
+			Acls: []v3.DBAASOpensearchAclConfigAcls{
+				{Username: "app", Rules: []v3.DBAASOpensearchAclConfigAclsRules{{Index: "*", Permission: "admin"}}},
+				{Username: "other", Rules: []v3.DBAASOpensearchAclConfigAclsRules{{Index: "metrics-*", Permission: "read"}}},
+			},
+		})
+	})
+	mux.HandleFunc("/dbaas-valkey/testvk", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.DBAASServiceValkey{
+			Users: []v3.DBAASServiceValkeyUsers{{
+				Username: "app",
+				AccessControl: &v3.DBAASServiceValkeyUsersAccessControl{
+					Keys:       []string{"*"},
+					Categories: []string{"+@all"},
+				},
+			}},
+		})
+	})
+	mux.HandleFunc("/dbaas-valkey/testvk/user/app", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotValkey))
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op"), State: v3.OperationStateSuccess})
+	})
+	mux.HandleFunc("/operation/", func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{ID: v3.UUID("op"), State: v3.OperationStateSuccess})
+	})
+	ts = httptest.NewServer(mux)
+	defer ts.Close()
+	testutils.SetupV3Client(t, ts.URL)
+
+	update := func(args ...string) error {
+		return runDBAASTestCommand(t, func(root *cobra.Command) error {
+			return exocmd.RegisterCLICommand(root, &dbaasUserAccessUpdateCmd{CliCommandSettings: exocmd.DefaultCLICmdSettings()})
+		}, append([]string{"update", "--zone", "test-zone"}, args...)...)
+	}
+
+	require.NoError(t, update("testos", "app",
+		"--opensearch-acl-enabled",
+		"--opensearch-rule", "logs-*=read",
+		"--opensearch-rule", "app-*=readwrite"))
+	require.NotNil(t, gotOpensearch.AclEnabled)
+	assert.True(t, *gotOpensearch.AclEnabled)
+	assert.Equal(t, []v3.DBAASOpensearchAclConfigAcls{
+		{Username: "other", Rules: []v3.DBAASOpensearchAclConfigAclsRules{{Index: "metrics-*", Permission: "read"}}},
+		{Username: "app", Rules: []v3.DBAASOpensearchAclConfigAclsRules{
+			{Index: "logs-*", Permission: "read"},
+			{Index: "app-*", Permission: "readwrite"},
+		}},
+	}, gotOpensearch.Acls)
+
+	assert.ErrorContains(t, update("testos", "app", "--opensearch-rule", "logs-*=owner"), "invalid permission")
+	assert.ErrorContains(t, update("testos", "ghost", "--opensearch-rule", "logs-*=read"), "not found")
+	assert.ErrorContains(t, update("testos", "app", "--valkey-keys", "x"), "not supported for service of type \"opensearch\"")
+
+	require.NoError(t, update("testvk", "app", "--valkey-keys", "cache:*,session:*"))
+	assert.Equal(t, &v3.DBAASValkeyUserAccessControl{
+		Keys:       []string{"cache:*", "session:*"},
+		Categories: []string{"+@all"},
+	}, gotValkey.AccessControl)
+
+	assert.ErrorContains(t, update("testvk", "app"), "nothing to update")
+}
@@ -0,0 +1,171 @@
+package dbaas
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+)
+
+type dbaasUserAccessUpdateCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"update"`
+
+	Name     string `cli-arg:"#"`
+	Username string `cli-arg:"#"`
+
+	File           string `cli-short:"f" cli-usage:"JSON or YAML access control document to apply (\"-\" to read from standard input)"`
+	HelpOpensearch bool   `cli-usage:"show usage for flags specific to the opensearch type"`
+	HelpPg         bool   `cli-usage:"show usage for flags specific to the pg type"`
+	HelpValkey     bool   `cli-usage:"show usage for flags specific to the valkey type"`
+	Zone           string `cli-short:"z" cli-usage:"Database Service zone"`
+
+	// "opensearch" type specific flags
+	OpensearchACLEnabled         bool     `cli-flag:"opensearch-acl-enabled" cli-usage:"enable OpenSearch ACLs (when disabled, authenticated users have unrestricted access)" cli-hidden:""`
+	OpensearchExtendedACLEnabled bool     `cli-flag:"opensearch-extended-acl-enabled" cli-usage:"enforce index rules for requests using the _mget, _msearch and _bulk APIs" cli-hidden:""`
+	OpensearchRules              []string `cli-flag:"opensearch-rule" cli-usage:"user index rule, format INDEX-PATTERN=PERMISSION (admin|deny|read|readwrite|write), replaces the current rules (can be repeated)" cli-hidden:""`
+
+	// "pg" type specific flags
+	PgAllowReplication bool `cli-flag:"pg-allow-replication" cli-usage:"allow the user to use the replication protocol" cli-hidden:""`
+
+	// "valkey" type specific flags
+	ValkeyCategories []string `cli-flag:"valkey-categories" cli-usage:"command categories (e.g. \"+@all\", \"-@dangerous\"), replaces the current value (can be repeated)" cli-hidden:""`
+	ValkeyChannels   []string `cli-flag:"valkey-channels" cli-usage:"Pub/Sub channel patterns (e.g. \"events:*\"), replaces the current value (can be repeated)" cli-hidden:""`
+	ValkeyCommands   []string `cli-flag:"valkey-commands" cli-usage:"commands (e.g. \"+get\", \"-flushall\"), replaces the current value (can be repeated)" cli-hidden:""`
+	ValkeyKeys       []string `cli-flag:"valkey-keys" cli-usage:"key patterns (e.g. \"cache:*\"), replaces the current value (can be repeated)" cli-hidden:""`
+}
+
+func (c *dbaasUserAccessUpdateCmd) CmdAliases() []string { return nil }
+
+func (c *dbaasUserAccessUpdateCmd) CmdShort() string { return "Update the access control of a user" }
+
+func (c *dbaasUserAccessUpdateCmd) CmdLong() string {
+	return `This command updates the access control settings of a DBAAS user, for services
+of type opensearch (index rules), valkey (keys, channels, commands and categories)
+and pg (replication permission).
+
+Settings can be specified using type-specific flags (see --help-<type>), or
+using a JSON or YAML document (--file) such as:
+
+    # opensearch
+    acl_enabled: true
+    rules:
+      - index: "logs-*"
+        permission: read
+
+    # valkey
+    keys: ["cache:*"]
+    channels: ["events:*"]
+    commands: ["+get", "+set"]
+    categories: ["-@dangerous"]
+
+    # pg
+    allow_replication: true
+
+Flags take precedence over the document. Settings not specified are left
+unchanged.`
+}
+
+func (c *dbaasUserAccessUpdateCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	switch {
+	case cmd.Flags().Changed("help-opensearch"):
+		exocmd.CmdShowHelpFlags(cmd.Flags(), "opensearch-")
+		os.Exit(0)
+	case cmd.Flags().Changed("help-pg"):
+		exocmd.CmdShowHelpFlags(cmd.Flags(), "pg-")
+		os.Exit(0)
+	case cmd.Flags().Changed("help-valkey"):
+		exocmd.CmdShowHelpFlags(cmd.Flags(), "valkey-")
+		os.Exit(0)
+	}
+
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *dbaasUserAccessUpdateCmd) CmdRun(cmd *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	db, err := dbaasGetV3(ctx, c.Name, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	doc := &dbaasUserAccessDocument{}
+	if c.File != "" {
+		if doc, err = dbaasReadUserAccessDocument(c.File); err != nil {
+			return err
+		}
+	}
+
+	if err := c.applyFlags(cmd, doc); err != nil {
+		return err
+	}
+
+	if unsupported := doc.unsupportedFields(string(db.Type)); len(unsupported) > 0 {
+		return fmt.Errorf("settings not supported for service of type %q: %s", db.Type, strings.Join(unsupported, ", "))
+	}
+
+	switch db.Type {
+	case "opensearch":
+		return c.updateOpensearch(ctx, doc)
+	case "valkey":
+		return c.updateValkey(ctx, doc)
+	case "pg":
+		return c.updatePG(ctx, doc)
+	default:
+		return fmt.Errorf("user access control unsupported for service of type %q", db.Type)
+	}
+}
+
+// applyFlags overrides the document settings with the flags specified.
+func (c *dbaasUserAccessUpdateCmd) applyFlags(cmd *cobra.Command, doc *dbaasUserAccessDocument) error {
+	changed := func(field interface{}) bool {
+		return cmd.Flags().Changed(exocmd.MustCLICommandFlagName(c, field))
+	}
+
+	if changed(&c.OpensearchACLEnabled) {
+		doc.ACLEnabled = &c.OpensearchACLEnabled
+	}
+	if changed(&c.OpensearchExtendedACLEnabled) {
+		doc.ExtendedACLEnabled = &c.OpensearchExtendedACLEnabled
+	}
+	if changed(&c.OpensearchRules) {
+		doc.Rules = make([]dbaasOpensearchRule, 0, len(c.OpensearchRules))
+		for _, r := range c.OpensearchRules {
+			i := strings.LastIndex(r, "=")
+			if i <= 0 {
+				return fmt.Errorf("invalid rule %q, expected format INDEX-PATTERN=PERMISSION", r)
+			}
+			doc.Rules = append(doc.Rules, dbaasOpensearchRule{Index: r[:i], Permission: r[i+1:]})
+		}
+	}
+
+	if changed(&c.PgAllowReplication) {
+		doc.AllowReplication = &c.PgAllowReplication
+	}
+
+	if changed(&c.ValkeyCategories) {
+		doc.Categories = c.ValkeyCategories
+	}
+	if changed(&c.ValkeyChannels) {
+		doc.Channels = c.ValkeyChannels
+	}
+	if changed(&c.ValkeyCommands) {
+		doc.Commands = c.ValkeyCommands
+	}
+	if changed(&c.ValkeyKeys) {
+		doc.Keys = c.ValkeyKeys
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(dbaasUserAccessCmd, &dbaasUserAccessUpdateCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,114 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+// dbaasGetValkeyUserAccessControl returns the current access control
+// settings of a Valkey user.
+func dbaasGetValkeyUserAccessControl(ctx context.Context, client *v3.Client, name, username string) (v3.DBAASServiceValkeyUsersAccessControl, error) {
+	s, err := client.GetDBAASServiceValkey(ctx, name)
+	if err != nil {
+		return v3.DBAASServiceValkeyUsersAccessControl{}, err
+	}
+
+	for _, u := range s.Users {
+		if u.Username == username {
+			if u.AccessControl == nil {
+				return v3.DBAASServiceValkeyUsersAccessControl{}, nil
+			}
+			return *u.AccessControl, nil
+		}
+	}
+
+	return v3.DBAASServiceValkeyUsersAccessControl{}, fmt.Errorf("user %q not found for service %q", username, name)
+}
+
+func (c *dbaasUserAccessShowCmd) showValkey(ctx context.Context) (output.Outputter, error) {
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return nil, err
+	}
+
+	ac, err := dbaasGetValkeyUserAccessControl(ctx, client, c.Name, c.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbaasUserAccessShowOutput{
+		Username: c.Username,
+		Type:     "valkey",
+		dbaasUserAccessDocument: dbaasUserAccessDocument{
+			Categories: ac.Categories,
+			Channels:   ac.Channels,
+			Commands:   ac.Commands,
+			Keys:       ac.Keys,
+		},
+	}, nil
+}
+
+func (c *dbaasUserAccessUpdateCmd) updateValkey(ctx context.Context, doc *dbaasUserAccessDocument) error {
+	if doc.Categories == nil && doc.Channels == nil && doc.Commands == nil && doc.Keys == nil {
+		return fmt.Errorf("nothing to update")
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	current, err := dbaasGetValkeyUserAccessControl(ctx, client, c.Name, c.Username)
+	if err != nil {
+		return err
+	}
+
+	ac := v3.DBAASValkeyUserAccessControl{
+		Categories: current.Categories,
+		Channels:   current.Channels,
+		Commands:   current.Commands,
+		Keys:       current.Keys,
+	}
+	if doc.Categories != nil {
+		ac.Categories = doc.Categories
+	}
+	if doc.Channels != nil {
+		ac.Channels = doc.Channels
+	}
+	if doc.Commands != nil {
+		ac.Commands = doc.Commands
+	}
+	if doc.Keys != nil {
+		ac.Keys = doc.Keys
+	}
+
+	op, err := client.UpdateDBAASValkeyUserAccessControl(ctx, c.Name, c.Username, v3.UpdateDBAASValkeyUserAccessControlRequest{
+		AccessControl: &ac,
+	})
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Updating DBaaS user %q access control", c.Username), func() {
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return c.OutputFunc((&dbaasUserAccessShowCmd{
+			Name:     c.Name,
+			Zone:     c.Zone,
+			Username: c.Username,
+		}).showValkey(ctx))
+	}
+
+	return nil
+}
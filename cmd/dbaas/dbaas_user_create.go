@@ -59,6 +59,8 @@ func (c *dbaasUserCreateCmd) CmdRun(cmd *cobra.Command, args []string) error {
 	}
 
 	switch db.Type {
+	case "clickhouse":
+		return c.createClickhouse(cmd, args)
 	case "mysql":
 		return c.createMysql(cmd, args)
 	case "kafka":
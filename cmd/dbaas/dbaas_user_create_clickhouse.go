@@ -0,0 +1,53 @@
+package dbaas
+
+import (
+	"fmt"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+)
+
+func (c *dbaasUserCreateCmd) createClickhouse(cmd *cobra.Command, _ []string) error {
+
+	ctx := exocmd.GContext
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	s, err := client.GetDBAASServiceClickhouse(ctx, c.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(s.Users) == 0 {
+		return fmt.Errorf("service %q is not ready for user creation", c.Name)
+	}
+
+	req := v3.CreateDBAASClickhouseUserRequest{Username: v3.DBAASUserUsername(c.Username)}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Creating DBaaS user %q", c.Username), func() {
+		_, err = client.CreateDBAASClickhouseUser(ctx, c.Name, req)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+
+		return c.OutputFunc((&dbaasUserShowCmd{
+			Name:     c.Name,
+			Zone:     c.Zone,
+			Username: c.Username,
+		}).showClickhouse(ctx))
+
+	}
+
+	return nil
+
+}
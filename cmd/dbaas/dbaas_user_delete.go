@@ -41,6 +41,8 @@ func (c *dbaasUserDeleteCmd) CmdRun(cmd *cobra.Command, args []string) error {
 	}
 
 	switch db.Type {
+	case "clickhouse":
+		return c.deleteClickhouse(cmd, args)
 	case "mysql":
 		return c.deleteMysql(cmd, args)
 	case "kafka":
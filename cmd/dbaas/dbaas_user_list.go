@@ -53,6 +53,8 @@ func (c *dbaasUserListCmd) CmdRun(cmd *cobra.Command, args []string) error {
 	}
 
 	switch db.Type {
+	case "clickhouse":
+		return c.listClickhouse(cmd, args)
 	case "mysql":
 		return c.listMysql(cmd, args)
 	case "kafka":
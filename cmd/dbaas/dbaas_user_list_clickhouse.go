@@ -0,0 +1,45 @@
+package dbaas
+
+import (
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+)
+
+func (c *dbaasUserListCmd) listClickhouse(cmd *cobra.Command, _ []string) error {
+
+	ctx := exocmd.GContext
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	s, err := client.ListDBAASClickhouseUsers(ctx, c.Name)
+	if err != nil {
+		return err
+	}
+
+	res := make(dbaasUsersListOutput, 0)
+
+	for _, u := range s.Users {
+		res = append(res, dbaasUsersListItemOutput{
+			Username: string(u.Username),
+			Type:     clickhouseUserType(u),
+		})
+	}
+
+	return c.OutputFunc(&res, nil)
+}
+
+// clickhouseUserType returns a user type label for a ClickHouse user, as the
+// API doesn't expose one: users required by the service are reported as
+// "primary", the others as "normal".
+func clickhouseUserType(u v3.DBAASClickhouseUser) string {
+	if u.Required != nil && *u.Required {
+		return "primary"
+	}
+
+	return "normal"
+}
@@ -56,6 +56,8 @@ func (c *dbaasUserResetCmd) CmdRun(cmd *cobra.Command, args []string) error {
 	}
 
 	switch db.Type {
+	case "clickhouse":
+		return c.resetClickhouse(cmd, args)
 	case "mysql":
 		return c.resetMysql(cmd, args)
 	case "kafka":
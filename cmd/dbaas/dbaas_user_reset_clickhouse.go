@@ -0,0 +1,44 @@
+package dbaas
+
+import (
+	"fmt"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/spf13/cobra"
+)
+
+func (c *dbaasUserResetCmd) resetClickhouse(cmd *cobra.Command, _ []string) error {
+
+	ctx := exocmd.GContext
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	req := v3.ResetDBAASClickhouseUserPasswordRequest{}
+	if c.Password != "" {
+		req.Password = v3.DBAASUserPassword(c.Password)
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Resetting DBaaS user %q", c.Username), func() {
+		_, err = client.ResetDBAASClickhouseUserPassword(ctx, c.Name, c.Username, req)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return c.OutputFunc((&dbaasUserShowCmd{
+			Name:     c.Name,
+			Zone:     c.Zone,
+			Username: c.Username,
+		}).showClickhouse(ctx))
+	}
+
+	return nil
+}
@@ -70,6 +70,8 @@ func (c *dbaasUserRevealCmd) CmdRun(cmd *cobra.Command, args []string) error {
 	}
 
 	switch db.Type {
+	case "clickhouse":
+		return c.OutputFunc(c.revealClickhouse(ctx))
 	case "mysql":
 		return c.OutputFunc(c.revealMysql(ctx))
 	case "kafka":
@@ -16,8 +16,9 @@ type dbaasUserShowOutput struct {
 	Type     string `json:"type,omitempty"`
 
 	// Additional user info for some DBAAS Services
-	MySQL *dbaasMysqlUserShowOutput `json:"mysql,omitempty"`
-	PG    *dbaasPGUserShowOutput    `json:"pg,omitempty"`
+	Clickhouse *dbaasClickhouseUserShowOutput `json:"clickhouse,omitempty"`
+	MySQL      *dbaasMysqlUserShowOutput      `json:"mysql,omitempty"`
+	PG         *dbaasPGUserShowOutput         `json:"pg,omitempty"`
 }
 
 func (o *dbaasUserShowOutput) ToJSON() { output.JSON(o) }
@@ -33,6 +34,8 @@ func (o *dbaasUserShowOutput) ToTable() {
 	t.Append([]string{"Type", o.Type})
 
 	switch {
+	case o.Clickhouse != nil:
+		o.Clickhouse.formatUser(t)
 	case o.MySQL != nil:
 		o.MySQL.formatUser(t)
 	case o.PG != nil:
@@ -74,6 +77,8 @@ func (c *dbaasUserShowCmd) CmdRun(cmd *cobra.Command, args []string) error {
 	}
 
 	switch db.Type {
+	case "clickhouse":
+		return c.OutputFunc(c.showClickhouse(ctx))
 	case "mysql":
 		return c.OutputFunc(c.showMysql(ctx))
 	case "kafka":
@@ -0,0 +1,106 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/table"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type dbaasClickhouseUserShowOutput struct {
+	Roles      []string `json:"roles,omitempty"`
+	Privileges []string `json:"privileges,omitempty"`
+}
+
+func (o *dbaasClickhouseUserShowOutput) formatUser(t *table.Table) {
+	t.Append([]string{"Roles", func() string {
+		if len(o.Roles) > 0 {
+			return strings.Join(o.Roles, "\n")
+		}
+		return "n/a"
+	}()})
+	t.Append([]string{"Privileges", func() string {
+		if len(o.Privileges) > 0 {
+			return strings.Join(o.Privileges, "\n")
+		}
+		return "n/a"
+	}()})
+}
+
+// formatClickhousePrivilege returns a human-readable representation of a
+// ClickHouse privilege, e.g. "SELECT ON db.table".
+func formatClickhousePrivilege(p v3.DBAASClickhouseUserPrivilege) string {
+	target := "*"
+	if p.Database != "" {
+		target = p.Database
+	}
+	if p.Table != "" {
+		target += "." + p.Table
+	} else {
+		target += ".*"
+	}
+	if p.Column != "" {
+		target += fmt.Sprintf("(%s)", p.Column)
+	}
+
+	s := fmt.Sprintf("%s ON %s", p.AccessType, target)
+	if p.PartialRevoke != nil && *p.PartialRevoke {
+		s = "REVOKE " + s
+	}
+	if p.GrantOption != nil && *p.GrantOption {
+		s += " WITH GRANT OPTION"
+	}
+
+	return s
+}
+
+func (c *dbaasUserShowCmd) showClickhouse(ctx context.Context) (output.Outputter, error) {
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return &dbaasUserShowOutput{}, err
+	}
+
+	s, err := client.ListDBAASClickhouseUsers(ctx, c.Name)
+	if err != nil {
+		return &dbaasUserShowOutput{}, err
+	}
+
+	for _, u := range s.Users {
+		if string(u.Username) != c.Username {
+			continue
+		}
+
+		out := &dbaasUserShowOutput{
+			Username:   c.Username,
+			Type:       clickhouseUserType(u),
+			Clickhouse: &dbaasClickhouseUserShowOutput{},
+		}
+
+		acl, err := client.GetDBAASClickhouseAclConfig(ctx, c.Name)
+		if err != nil {
+			return &dbaasUserShowOutput{}, err
+		}
+
+		for _, a := range acl.Users {
+			if string(a.Username) != c.Username {
+				continue
+			}
+			for _, r := range a.Roles {
+				out.Clickhouse.Roles = append(out.Clickhouse.Roles, r.Name)
+			}
+			for _, p := range a.Privileges {
+				out.Clickhouse.Privileges = append(out.Clickhouse.Privileges, formatClickhousePrivilege(p))
+			}
+		}
+
+		return out, nil
+	}
+
+	return &dbaasUserShowOutput{}, fmt.Errorf("user %q not found for service %q", c.Username, c.Name)
+}
@@ -0,0 +1,57 @@
+package dbaas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+func TestFormatClickhousePrivilege(t *testing.T) {
+	yes := true
+
+	tests := []struct {
+		name      string
+		privilege v3.DBAASClickhouseUserPrivilege
+		expected  string
+	}{
+		{
+			name:      "global",
+			privilege: v3.DBAASClickhouseUserPrivilege{AccessType: "SHOW DATABASES"},
+			expected:  "SHOW DATABASES ON *.*",
+		},
+		{
+			name:      "database",
+			privilege: v3.DBAASClickhouseUserPrivilege{AccessType: "SELECT", Database: "analytics"},
+			expected:  "SELECT ON analytics.*",
+		},
+		{
+			name: "column with grant option",
+			privilege: v3.DBAASClickhouseUserPrivilege{
+				AccessType:  "SELECT",
+				Database:    "analytics",
+				Table:       "events",
+				Column:      "user_id",
+				GrantOption: &yes,
+			},
+			expected: "SELECT ON analytics.events(user_id) WITH GRANT OPTION",
+		},
+		{
+			name: "partial revoke",
+			privilege: v3.DBAASClickhouseUserPrivilege{
+				AccessType:    "INSERT",
+				Database:      "analytics",
+				Table:         "events",
+				PartialRevoke: &yes,
+			},
+			expected: "REVOKE INSERT ON analytics.events",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, formatClickhousePrivilege(tt.privilege))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+func init() {
+	dnsExportCmd := &cobra.Command{
+		Use:   "export DOMAIN-NAME|ID",
+		Short: "Export a domain as a zone file",
+		Long: `This command exports a DNS domain records as an RFC 1035 (BIND-format) zone
+file, printed on the standard output unless --file is specified.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return cmd.Usage()
+			}
+
+			file, err := cmd.Flags().GetString("file")
+			if err != nil {
+				return err
+			}
+
+			return exportDomain(args[0], file)
+		},
+	}
+	dnsCmd.AddCommand(dnsExportCmd)
+	dnsExportCmd.Flags().String("file", "", "Write the zone file to FILE instead of the standard output")
+}
+
+func exportDomain(ident, file string) error {
+	ctx := exocmd.GContext
+	client := globalstate.EgoscaleV3Client
+
+	domainsList, err := client.ListDNSDomains(ctx)
+	if err != nil {
+		return err
+	}
+	domain, err := domainsList.FindDNSDomain(ident)
+	if err != nil {
+		if errors.Is(err, v3.ErrNotFound) {
+			return fmt.Errorf("resource not found")
+		}
+		return err
+	}
+
+	zone, err := client.GetDNSDomainZoneFile(ctx, domain.ID)
+	if err != nil {
+		return err
+	}
+
+	content := zone.ZoneFile
+	if !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+
+	if file == "" {
+		_, err = fmt.Fprint(os.Stdout, content)
+		return err
+	}
+
+	if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+		return fmt.Errorf("unable to write zone file: %w", err)
+	}
+
+	if !globalstate.Quiet {
+		fmt.Fprintf(os.Stderr, "Domain %q exported to %s\n", domain.UnicodeName, file)
+	}
+
+	return nil
+}
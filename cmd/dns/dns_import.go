@@ -0,0 +1,256 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+const (
+	dnsImportActionCreate    = "create"
+	dnsImportActionUpdate    = "update"
+	dnsImportActionUnchanged = "unchanged"
+	dnsImportActionIgnored   = "ignored"
+	dnsImportActionUntouched = "untouched"
+)
+
+type dnsImportItemOutput struct {
+	Action     string `json:"action"`
+	Name       string `json:"name"`
+	RecordType string `json:"record_type"`
+	Content    string `json:"content"`
+	Prio       int64  `json:"prio,omitempty"`
+	TTL        int64  `json:"ttl,omitempty"`
+
+	// RecordID is the ID of the existing record to update.
+	RecordID v3.UUID `json:"-" output:"-"`
+}
+
+type dnsImportOutput []dnsImportItemOutput
+
+func (o *dnsImportOutput) ToJSON()  { output.JSON(o) }
+func (o *dnsImportOutput) ToText()  { output.Text(o) }
+func (o *dnsImportOutput) ToTable() { output.Table(o) }
+
+func init() {
+	dnsImportCmd := &cobra.Command{
+		Use:   "import DOMAIN-NAME|ID FILE",
+		Short: "Import records from a zone file",
+		Long: fmt.Sprintf(`This command creates the records of a BIND-format (RFC 1035) zone file
+into an existing DNS domain.
+
+Records already present in the domain are left unchanged, except for their
+TTL which is updated if it differs, and records of the domain absent from the
+zone file are not deleted. SOA and apex NS records are
+managed by Exoscale and are ignored. Use --dry-run to review the changes
+without applying them.
+
+Supported output template annotations: %s`,
+			strings.Join(output.TemplateAnnotations(&dnsImportItemOutput{}), ", ")),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return cmd.Usage()
+			}
+
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return err
+			}
+
+			force, err := cmd.Flags().GetBool("force")
+			if err != nil {
+				return err
+			}
+
+			return importDomain(args[0], args[1], dryRun, force)
+		},
+	}
+	dnsCmd.AddCommand(dnsImportCmd)
+	dnsImportCmd.Flags().Bool("dry-run", false, "Show the changes without applying them")
+	dnsImportCmd.Flags().BoolP("force", "f", false, exocmd.CmdFlagForceHelp)
+}
+
+func importDomain(ident, file string, dryRun, force bool) error {
+	ctx := exocmd.GContext
+	client := globalstate.EgoscaleV3Client
+
+	domainsList, err := client.ListDNSDomains(ctx)
+	if err != nil {
+		return err
+	}
+	domain, err := domainsList.FindDNSDomain(ident)
+	if err != nil {
+		if errors.Is(err, v3.ErrNotFound) {
+			return fmt.Errorf("resource not found")
+		}
+		return err
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	records, err := parseZoneFile(f, domain.UnicodeName)
+	if err != nil {
+		return fmt.Errorf("unable to parse zone file: %w", err)
+	}
+
+	existing, err := client.ListDNSDomainRecords(ctx, domain.ID)
+	if err != nil {
+		return err
+	}
+
+	plan := planDomainImport(records, existing.DNSDomainRecords)
+
+	if dryRun {
+		return utils.PrintOutput(&plan, nil)
+	}
+
+	var toCreate, toUpdate []dnsImportItemOutput
+	for _, item := range plan {
+		switch item.Action {
+		case dnsImportActionCreate:
+			toCreate = append(toCreate, item)
+		case dnsImportActionUpdate:
+			toUpdate = append(toUpdate, item)
+		}
+	}
+	if len(toCreate)+len(toUpdate) == 0 {
+		if !globalstate.Quiet {
+			fmt.Printf("Domain %q is already up-to-date\n", domain.UnicodeName)
+		}
+		return nil
+	}
+
+	if !force && !utils.AskQuestion(ctx, fmt.Sprintf(
+		"Are you sure you want to create %d and update %d record(s) in %q domain?",
+		len(toCreate), len(toUpdate), domain.UnicodeName)) {
+		return nil
+	}
+
+	fns := make([]func() error, 0, len(toCreate)+len(toUpdate))
+	for _, item := range toCreate {
+		fns = append(fns, func() error {
+			op, err := client.CreateDNSDomainRecord(ctx, domain.ID, v3.CreateDNSDomainRecordRequest{
+				Name:     item.Name,
+				Type:     v3.CreateDNSDomainRecordRequestType(item.RecordType),
+				Content:  item.Content,
+				Ttl:      item.TTL,
+				Priority: item.Prio,
+			})
+			if err != nil {
+				return fmt.Errorf("%s record %q: %w", item.RecordType, item.Name, err)
+			}
+			_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+			return err
+		})
+	}
+	for _, item := range toUpdate {
+		fns = append(fns, func() error {
+			op, err := client.UpdateDNSDomainRecord(ctx, domain.ID, item.RecordID, v3.UpdateDNSDomainRecordRequest{
+				Ttl: item.TTL,
+			})
+			if err != nil {
+				return fmt.Errorf("%s record %q: %w", item.RecordType, item.Name, err)
+			}
+			_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+			return err
+		})
+	}
+
+	err = utils.DecorateAsyncOperations(fmt.Sprintf("Importing DNS records to %q...", domain.UnicodeName), fns...)
+	if err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		fmt.Printf("%d record(s) imported successfully to %q\n", len(toCreate)+len(toUpdate), domain.UnicodeName)
+	}
+
+	return nil
+}
+
+// planDomainImport compares the records parsed from a zone file with the
+// existing records of a domain, and returns the action to be performed for
+// each of them. Records are identified by their name and type, compared
+// case-insensitively, their content and their priority; existing records
+// having a different TTL are updated.
+func planDomainImport(records []zoneRecord, existing []v3.DNSDomainRecord) dnsImportOutput {
+	key := func(name, rType, content string, prio int64) string {
+		return fmt.Sprintf("%s|%s|%s|%d",
+			strings.ToLower(name), strings.ToUpper(rType), strings.TrimSuffix(content, "."), prio)
+	}
+
+	current := make(map[string]v3.DNSDomainRecord, len(existing))
+	for _, r := range existing {
+		current[key(r.Name, string(r.Type), r.Content, r.Priority)] = r
+	}
+
+	plan := dnsImportOutput{}
+	seen := make(map[string]struct{}, len(records))
+	for _, r := range records {
+		action := dnsImportActionCreate
+
+		var recordID v3.UUID
+
+		k := key(r.Name, r.Type, r.Content, r.Priority)
+		e, exists := current[k]
+		switch {
+		case r.Type == "SOA", r.Type == "NS" && r.Name == "":
+			action = dnsImportActionIgnored
+		case hasKey(seen, k):
+			action = dnsImportActionUnchanged
+		case exists && r.TTL > 0 && r.TTL != e.Ttl:
+			action, recordID = dnsImportActionUpdate, e.ID
+		case exists:
+			action = dnsImportActionUnchanged
+		}
+		seen[k] = struct{}{}
+
+		plan = append(plan, dnsImportItemOutput{
+			Action:     action,
+			Name:       r.Name,
+			RecordType: r.Type,
+			Content:    r.Content,
+			Prio:       r.Priority,
+			TTL:        r.TTL,
+			RecordID:   recordID,
+		})
+	}
+
+	for _, r := range existing {
+		if r.SystemRecord != nil && *r.SystemRecord {
+			continue
+		}
+		if hasKey(seen, key(r.Name, string(r.Type), r.Content, r.Priority)) {
+			continue
+		}
+
+		plan = append(plan, dnsImportItemOutput{
+			Action:     dnsImportActionUntouched,
+			Name:       r.Name,
+			RecordType: string(r.Type),
+			Content:    r.Content,
+			Prio:       r.Priority,
+			TTL:        r.Ttl,
+		})
+	}
+
+	return plan
+}
+
+func hasKey(m map[string]struct{}, k string) bool {
+	_, ok := m[k]
+	return ok
+}
@@ -0,0 +1,403 @@
+package dns
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// zoneRecord represents a resource record parsed from a zone file, expressed
+// the way the Exoscale DNS API expects it: the name is relative to the domain
+// ("" for the apex), MX and SRV priorities are stored separately from the
+// record content and hostnames are fully-qualified without trailing dot.
+type zoneRecord struct {
+	Name     string
+	Type     string
+	Content  string
+	TTL      int64
+	Priority int64
+}
+
+// zoneRecordTypes lists the record types supported by the Exoscale DNS service.
+var zoneRecordTypes = map[string]struct{}{
+	"A":     {},
+	"AAAA":  {},
+	"ALIAS": {},
+	"CAA":   {},
+	"CNAME": {},
+	"HINFO": {},
+	"MX":    {},
+	"NAPTR": {},
+	"NS":    {},
+	"POOL":  {},
+	"SOA":   {},
+	"SPF":   {},
+	"SRV":   {},
+	"SSHFP": {},
+	"TXT":   {},
+	"URL":   {},
+}
+
+// zoneHostnameTypes lists the record types whose content is a single
+// hostname, which may be expressed relative to the current origin.
+var zoneHostnameTypes = map[string]struct{}{
+	"ALIAS": {},
+	"CNAME": {},
+	"NS":    {},
+	"POOL":  {},
+}
+
+// parseZoneFile parses a BIND-format (RFC 1035) zone file for the domain
+// specified. $ORIGIN and $TTL directives, "@" owners, omitted owners, TTL
+// units, multi-line records in parentheses, quoted strings and comments are
+// supported; $INCLUDE and $GENERATE are not.
+func parseZoneFile(r io.Reader, domain string) ([]zoneRecord, error) {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	var (
+		records    []zoneRecord
+		origin     = domain + "."
+		defaultTTL int64
+		lastTTL    int64
+		lastOwner  string
+		lineNo     int
+	)
+
+	lines, err := readZoneLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		lineNo = line.number
+		tokens := line.tokens
+		if len(tokens) == 0 {
+			continue
+		}
+
+		if strings.HasPrefix(tokens[0], "$") {
+			switch strings.ToUpper(tokens[0]) {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN expects one argument", lineNo)
+				}
+				origin = zoneAbsoluteName(tokens[1], origin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL expects one argument", lineNo)
+				}
+				if defaultTTL, err = parseZoneTTL(tokens[1]); err != nil {
+					return nil, fmt.Errorf("line %d: %w", lineNo, err)
+				}
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", lineNo, tokens[0])
+			}
+			continue
+		}
+
+		owner := lastOwner
+		if !line.indented {
+			owner = zoneAbsoluteName(tokens[0], origin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: missing record owner name", lineNo)
+		}
+		lastOwner = owner
+
+		ttl := int64(-1)
+		for len(tokens) > 0 {
+			if _, ok := zoneRecordTypes[strings.ToUpper(tokens[0])]; ok {
+				break
+			}
+			switch strings.ToUpper(tokens[0]) {
+			case "IN":
+			case "CH", "HS", "CS":
+				return nil, fmt.Errorf("line %d: unsupported record class %s", lineNo, tokens[0])
+			default:
+				if ttl, err = parseZoneTTL(tokens[0]); err != nil {
+					return nil, fmt.Errorf("line %d: unsupported record type %q", lineNo, tokens[0])
+				}
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: missing record type", lineNo)
+		}
+
+		switch {
+		case ttl >= 0:
+			lastTTL = ttl
+		case defaultTTL > 0:
+			ttl = defaultTTL
+		default:
+			ttl = lastTTL
+		}
+
+		name, err := zoneRelativeName(owner, domain)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+
+		record, err := newZoneRecord(name, strings.ToUpper(tokens[0]), tokens[1:], origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		record.TTL = ttl
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// newZoneRecord builds a zoneRecord from the RDATA tokens of a zone file entry.
+func newZoneRecord(name, rType string, rdata []string, origin string) (zoneRecord, error) {
+	record := zoneRecord{Name: name, Type: rType}
+
+	if len(rdata) == 0 {
+		return record, fmt.Errorf("missing %s record data", rType)
+	}
+
+	switch rType {
+	case "MX":
+		if len(rdata) != 2 {
+			return record, fmt.Errorf("invalid MX record data %q", strings.Join(rdata, " "))
+		}
+		priority, err := strconv.ParseInt(rdata[0], 10, 64)
+		if err != nil {
+			return record, fmt.Errorf("invalid MX record priority %q", rdata[0])
+		}
+		record.Priority = priority
+		record.Content = zoneHostname(rdata[1], origin)
+
+	case "SRV":
+		if len(rdata) != 4 {
+			return record, fmt.Errorf("invalid SRV record data %q", strings.Join(rdata, " "))
+		}
+		priority, err := strconv.ParseInt(rdata[0], 10, 64)
+		if err != nil {
+			return record, fmt.Errorf("invalid SRV record priority %q", rdata[0])
+		}
+		record.Priority = priority
+		record.Content = strings.Join([]string{rdata[1], rdata[2], zoneHostname(rdata[3], origin)}, " ")
+
+	case "TXT", "SPF":
+		var content strings.Builder
+		for _, s := range rdata {
+			content.WriteString(zoneUnquote(s))
+		}
+		record.Content = content.String()
+
+	default:
+		if _, ok := zoneHostnameTypes[rType]; ok {
+			if len(rdata) != 1 {
+				return record, fmt.Errorf("invalid %s record data %q", rType, strings.Join(rdata, " "))
+			}
+			record.Content = zoneHostname(rdata[0], origin)
+			break
+		}
+		record.Content = strings.Join(rdata, " ")
+	}
+
+	return record, nil
+}
+
+type zoneLine struct {
+	number   int
+	indented bool
+	tokens   []string
+}
+
+// readZoneLines splits a zone file into logical lines of tokens, stripping
+// comments and joining lines enclosed in parentheses. Quoted strings are
+// kept as a single token including their quotes.
+func readZoneLines(r io.Reader) ([]zoneLine, error) {
+	var (
+		lines   []zoneLine
+		current *zoneLine
+		depth   int
+		lineNo  int
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lineNo++
+		text := scanner.Text()
+
+		if current == nil {
+			current = &zoneLine{
+				number:   lineNo,
+				indented: len(text) > 0 && (text[0] == ' ' || text[0] == '\t'),
+			}
+		}
+
+		var (
+			token   strings.Builder
+			inQuote bool
+		)
+		flush := func() {
+			if token.Len() > 0 {
+				current.tokens = append(current.tokens, token.String())
+				token.Reset()
+			}
+		}
+
+	chars:
+		for i := 0; i < len(text); i++ {
+			c := text[i]
+			switch {
+			case c == '\\' && i+1 < len(text):
+				token.WriteByte(c)
+				token.WriteByte(text[i+1])
+				i++
+			case c == '"':
+				token.WriteByte(c)
+				if inQuote {
+					flush()
+				}
+				inQuote = !inQuote
+			case inQuote:
+				token.WriteByte(c)
+			case c == ';':
+				break chars
+			case c == '(':
+				flush()
+				depth++
+			case c == ')':
+				flush()
+				if depth == 0 {
+					return nil, fmt.Errorf("line %d: unbalanced parentheses", lineNo)
+				}
+				depth--
+			case c == ' ' || c == '\t':
+				flush()
+			default:
+				token.WriteByte(c)
+			}
+		}
+		if inQuote {
+			return nil, fmt.Errorf("line %d: unterminated quoted string", lineNo)
+		}
+		flush()
+
+		if depth == 0 {
+			if len(current.tokens) > 0 {
+				lines = append(lines, *current)
+			}
+			current = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.number)
+	}
+
+	return lines, nil
+}
+
+// parseZoneTTL parses a TTL value, either as a number of seconds or using
+// the BIND unit suffixes (e.g. "1h30m", "1w").
+func parseZoneTTL(s string) (int64, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil && v >= 0 {
+		return v, nil
+	}
+
+	var total, n int64
+	var digits bool
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			n = n*10 + int64(c-'0')
+			digits = true
+			continue
+		}
+		if !digits {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		switch c {
+		case 's':
+		case 'm':
+			n *= 60
+		case 'h':
+			n *= 3600
+		case 'd':
+			n *= 86400
+		case 'w':
+			n *= 604800
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		total += n
+		n, digits = 0, false
+	}
+	if digits {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+
+	return total, nil
+}
+
+// zoneAbsoluteName returns the fully-qualified form (with trailing dot) of
+// a zone file name relative to origin.
+func zoneAbsoluteName(name, origin string) string {
+	switch {
+	case name == "@":
+		return strings.ToLower(origin)
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name + "." + origin)
+	}
+}
+
+// zoneRelativeName returns the name of a fully-qualified owner relative to
+// domain, as expected by the Exoscale DNS API.
+func zoneRelativeName(fqdn, domain string) (string, error) {
+	fqdn = strings.TrimSuffix(fqdn, ".")
+	if fqdn == domain {
+		return "", nil
+	}
+	if name, ok := strings.CutSuffix(fqdn, "."+domain); ok {
+		return name, nil
+	}
+
+	return "", fmt.Errorf("record %q is out of zone %q", fqdn, domain)
+}
+
+// zoneHostname expands a hostname found in record data relative to origin
+// and strips its trailing dot.
+func zoneHostname(name, origin string) string {
+	return strings.TrimSuffix(zoneAbsoluteName(name, origin), ".")
+}
+
+// zoneUnquote removes the surrounding quotes of a zone file character
+// string and decodes its escape sequences (\X and \DDD).
+func zoneUnquote(s string) string {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 >= len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+3 < len(s) {
+			if v, err := strconv.ParseUint(s[i+1:i+4], 10, 8); err == nil {
+				b.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i+1])
+		i++
+	}
+
+	return b.String()
+}
@@ -0,0 +1,132 @@
+package dns
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+const testZoneFile = `$ORIGIN example.net.
+$TTL 1h
+@	IN	SOA	ns1.exoscale.ch. support.exoscale.ch. (
+		2024010101 ; serial
+		10800      ; refresh
+		3600       ; retry
+		604800     ; expire
+		3600 )     ; minimum
+@		IN	NS	ns1.exoscale.ch.
+@	300	IN	A	192.0.2.1
+		IN	AAAA	2001:db8::1
+www		IN	CNAME	@
+mail	1d	IN	A	192.0.2.2
+@		MX	10 mail
+@		IN	TXT	"v=spf1 mx -all"
+dkim._domainkey	IN	TXT	( "v=DKIM1; k=rsa; "
+			  "p=MIGfMA0G\"x\"" )
+_sip._tcp	IN	SRV	10 20 5060 sip.example.org.
+@		IN	CAA	0 issue "letsencrypt.org"
+
+$ORIGIN sub.example.net.
+api		IN	A	192.0.2.3 ; comment
+`
+
+func TestParseZoneFile(t *testing.T) {
+	records, err := parseZoneFile(strings.NewReader(testZoneFile), "example.net")
+	require.NoError(t, err)
+
+	expected := []zoneRecord{
+		{Name: "", Type: "SOA", Content: "ns1.exoscale.ch. support.exoscale.ch. 2024010101 10800 3600 604800 3600", TTL: 3600},
+		{Name: "", Type: "NS", Content: "ns1.exoscale.ch", TTL: 3600},
+		{Name: "", Type: "A", Content: "192.0.2.1", TTL: 300},
+		{Name: "", Type: "AAAA", Content: "2001:db8::1", TTL: 3600},
+		{Name: "www", Type: "CNAME", Content: "example.net", TTL: 3600},
+		{Name: "mail", Type: "A", Content: "192.0.2.2", TTL: 86400},
+		{Name: "", Type: "MX", Content: "mail.example.net", Priority: 10, TTL: 3600},
+		{Name: "", Type: "TXT", Content: "v=spf1 mx -all", TTL: 3600},
+		{Name: "dkim._domainkey", Type: "TXT", Content: `v=DKIM1; k=rsa; p=MIGfMA0G"x"`, TTL: 3600},
+		{Name: "_sip._tcp", Type: "SRV", Content: "20 5060 sip.example.org", Priority: 10, TTL: 3600},
+		{Name: "", Type: "CAA", Content: `0 issue "letsencrypt.org"`, TTL: 3600},
+		{Name: "api.sub", Type: "A", Content: "192.0.2.3", TTL: 3600},
+	}
+	assert.Equal(t, expected, records)
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		zone string
+		err  string
+	}{
+		{"out of zone", "foo.example.com. IN A 192.0.2.1\n", "out of zone"},
+		{"unknown type", "@ IN BOGUS 1\n", "unsupported record type"},
+		{"unsupported directive", "$INCLUDE other.zone\n", "unsupported directive"},
+		{"unbalanced parentheses", "@ IN TXT ( \"a\"\n", "unbalanced parentheses"},
+		{"invalid MX", "@ IN MX mail\n", "invalid MX record data"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseZoneFile(strings.NewReader(tt.zone), "example.net")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestParseZoneTTL(t *testing.T) {
+	for in, expected := range map[string]int64{"3600": 3600, "1h": 3600, "1h30m": 5400, "2w": 1209600, "1D": 86400} {
+		ttl, err := parseZoneTTL(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, expected, ttl, in)
+	}
+
+	for _, in := range []string{"h", "1x", "10m5"} {
+		_, err := parseZoneTTL(in)
+		assert.Error(t, err, in)
+	}
+}
+
+func TestPlanDomainImport(t *testing.T) {
+	system := true
+	records := []zoneRecord{
+		{Name: "", Type: "SOA", Content: "ns1.exoscale.ch. support.exoscale.ch. 1 2 3 4 5"},
+		{Name: "", Type: "NS", Content: "ns1.exoscale.ch"},
+		{Name: "www", Type: "A", Content: "192.0.2.1", TTL: 300},
+		{Name: "", Type: "MX", Content: "mail.example.net", Priority: 10},
+		{Name: "api", Type: "A", Content: "192.0.2.2"},
+		{Name: "Cdn", Type: "cname", Content: "edge.example.net", TTL: 60},
+		{Name: "", Type: "TXT", Content: "v=DKIM1; p=ABC"},
+	}
+	existing := []v3.DNSDomainRecord{
+		{Name: "", Type: "NS", Content: "ns1.exoscale.ch", SystemRecord: &system},
+		{Name: "www", Type: "A", Content: "192.0.2.1", Ttl: 300},
+		{ID: "11111111-1111-1111-1111-111111111111", Name: "cdn", Type: "CNAME", Content: "edge.example.net.", Ttl: 3600},
+		{Name: "", Type: "TXT", Content: "v=DKIM1; p=abc"},
+		{Name: "", Type: "MX", Content: "mail.example.net.", Priority: 10},
+		{Name: "old", Type: "A", Content: "192.0.2.9"},
+	}
+
+	plan := planDomainImport(records, existing)
+
+	actions := make([]string, 0, len(plan))
+	for _, item := range plan {
+		actions = append(actions, item.Action+" "+item.Name)
+	}
+	assert.Equal(t, []string{
+		"ignored ",
+		"ignored ",
+		"unchanged www",
+		"unchanged ",
+		"create api",
+		"update Cdn",
+		"create ",
+		"untouched ",
+		"untouched old",
+	}, actions)
+	assert.Equal(t, v3.UUID("11111111-1111-1111-1111-111111111111"), plan[5].RecordID)
+	assert.Equal(t, int64(60), plan[5].TTL)
+}
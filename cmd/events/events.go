@@ -0,0 +1,17 @@
+package events
+
+import (
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+)
+
+var eventsCmd = &cobra.Command{
+	Use:     "events",
+	Short:   "Organization audit log",
+	Aliases: []string{"event"},
+}
+
+func init() {
+	exocmd.RootCmd.AddCommand(eventsCmd)
+}
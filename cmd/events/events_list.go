@@ -0,0 +1,321 @@
+package events
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/flags"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type eventListItemOutput struct {
+	Timestamp string `json:"timestamp" outputWidth:"20"`
+	Principal string `json:"principal" outputWidth:"30"`
+	SourceIP  string `json:"source_ip" outputWidth:"15"`
+	Zone      string `json:"zone" outputWidth:"8"`
+	Handler   string `json:"handler" outputWidth:"30"`
+	Status    int64  `json:"status" outputWidth:"6"`
+	URI       string `json:"uri" outputWidth:"50"`
+	Message   string `json:"message" output:"-"`
+	RequestID string `json:"request_id" output:"-"`
+}
+
+type eventListCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"list"`
+
+	Follow             bool   `cli-short:"f" cli-usage:"keep polling for new events and stream them as they happen"`
+	Interval           int64  `cli-usage:"polling interval in seconds in --follow mode"`
+	NewerThan          string `cli-usage:"only events newer than a duration. Accepts durations in the format of Go's time.ParseDuration. examples: \"2h45m\", \"10m\", \"45s\""`
+	NewerThanTimestamp string `cli-usage:"only events newer than an ISO 8601 timestamp. examples: '2023-06-07T10:00:00+02:00'"`
+	OlderThan          string `cli-usage:"only events older than a duration. Accepts durations in the format of Go's time.ParseDuration. examples: \"2h45m\", \"10m\", \"45s\""`
+	OlderThanTimestamp string `cli-usage:"only events older than an ISO 8601 timestamp. examples: '2023-06-07T10:00:00+02:00'"`
+	Principal          string `cli-usage:"only events performed by this IAM principal (user email or ID, API key name or key, role name or ID)"`
+	Resource           string `cli-usage:"only events targeting this resource (ID or request URI fragment)"`
+	Source             string `cli-usage:"only events originating from this source IP address or CIDR network"`
+}
+
+func (c *eventListCmd) CmdAliases() []string { return exocmd.GListAlias }
+
+func (c *eventListCmd) CmdShort() string { return "List organization events" }
+
+func (c *eventListCmd) CmdLong() string {
+	return fmt.Sprintf(`This command lists the mutation events (audit log) of the current
+organization. By default the API returns the events of the past 24 hours, use
+the --newer-than/--older-than flags (or their --*-timestamp variants) to
+select a different time window.
+
+In --follow mode the command keeps polling for new events, streaming them as
+they are recorded until interrupted; the global --query and --sort-by flags
+are not supported in this mode.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&eventListItemOutput{}), ", "))
+}
+
+func (c *eventListCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *eventListCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	return runEventList(c, os.Stdout, os.Stderr)
+}
+
+func runEventList(c *eventListCmd, stdout, stderr io.Writer) error {
+	ctx := exocmd.GContext
+	client := globalstate.EgoscaleV3Client
+
+	now := time.Now()
+
+	from, err := flags.ParseTimeBound(c.NewerThan, c.NewerThanTimestamp, now)
+	if err != nil {
+		return fmt.Errorf("invalid newer-than value: %w", err)
+	}
+
+	to, err := flags.ParseTimeBound(c.OlderThan, c.OlderThanTimestamp, now)
+	if err != nil {
+		return fmt.Errorf("invalid older-than value: %w", err)
+	}
+
+	if c.Follow && !to.IsZero() {
+		return fmt.Errorf("--older-than cannot be used in --follow mode")
+	}
+
+	// --query and --sort-by need all the events to be retrieved before
+	// printing any of them, which never happens in --follow mode.
+	if c.Follow && (globalstate.Query != "" || globalstate.SortBy != "") {
+		return fmt.Errorf("--query and --sort-by cannot be used in --follow mode")
+	}
+
+	if c.Follow && c.Interval <= 0 {
+		return fmt.Errorf("invalid polling interval %d", c.Interval)
+	}
+
+	filter, err := newEventFilter(c.Source, c.Principal, c.Resource)
+	if err != nil {
+		return err
+	}
+
+	streamer := output.NewStreamer(eventListItemOutput{}, stdout)
+	defer func() {
+		if err := streamer.Close(); err != nil {
+			_, _ = fmt.Fprintf(stderr, "error: %s\n", err)
+		}
+	}()
+
+	tracker := newEventTracker()
+
+	poll := func() error {
+		opts := make([]v3.ListEventsOpt, 0)
+		if since := tracker.since(from); !since.IsZero() {
+			opts = append(opts, v3.ListEventsWithFrom(since))
+		}
+		if !to.IsZero() {
+			opts = append(opts, v3.ListEventsWithTo(to))
+		}
+
+		events, err := client.ListEvents(ctx, opts...)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range tracker.fresh(events) {
+			if !filter(e) {
+				continue
+			}
+			if err := streamer.Push(eventListItem(e)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := poll(); err != nil || !c.Follow {
+		return err
+	}
+
+	ticker := time.NewTicker(time.Duration(c.Interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := poll(); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				// Transient errors must not end a long-running tail session.
+				_, _ = fmt.Fprintf(stderr, "warning: unable to retrieve events: %s\n", err)
+			}
+		}
+	}
+}
+
+func eventListItem(e v3.Event) eventListItemOutput {
+	return eventListItemOutput{
+		Timestamp: e.Timestamp.UTC().Format(time.RFC3339),
+		Principal: eventPrincipal(e),
+		SourceIP:  e.SourceIP,
+		Zone:      e.Zone,
+		Handler:   e.Handler,
+		Status:    e.Status,
+		URI:       e.URI,
+		Message:   e.Message,
+		RequestID: e.RequestID,
+	}
+}
+
+// eventPrincipal returns a human-readable representation of the IAM
+// principal that performed the operation recorded by an event.
+func eventPrincipal(e v3.Event) string {
+	switch {
+	case e.IAMUser != nil && e.IAMUser.Email != "":
+		return e.IAMUser.Email
+	case e.IAMAPIKey != nil && e.IAMAPIKey.Name != "":
+		return fmt.Sprintf("%s (%s)", e.IAMAPIKey.Name, e.IAMAPIKey.Key)
+	case e.IAMAPIKey != nil:
+		return e.IAMAPIKey.Key
+	case e.IAMRole != nil:
+		return e.IAMRole.Name
+	}
+
+	return ""
+}
+
+// newEventFilter returns a function reporting whether an event matches all
+// the specified criteria. Empty criteria match any event.
+func newEventFilter(source, principal, resource string) (func(v3.Event) bool, error) {
+	var sourceNet *net.IPNet
+
+	if source != "" && strings.Contains(source, "/") {
+		_, n, err := net.ParseCIDR(source)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source network: %w", err)
+		}
+		sourceNet = n
+	}
+
+	return func(e v3.Event) bool {
+		if source != "" {
+			if sourceNet != nil {
+				ip := net.ParseIP(e.SourceIP)
+				if ip == nil || !sourceNet.Contains(ip) {
+					return false
+				}
+			} else if e.SourceIP != source {
+				return false
+			}
+		}
+
+		if principal != "" && !eventMatchesPrincipal(e, principal) {
+			return false
+		}
+
+		if resource != "" && !eventMatchesResource(e, resource) {
+			return false
+		}
+
+		return true
+	}, nil
+}
+
+func eventMatchesPrincipal(e v3.Event, principal string) bool {
+	var candidates []string
+
+	if e.IAMUser != nil {
+		candidates = append(candidates, e.IAMUser.Email, e.IAMUser.ID.String())
+	}
+	if e.IAMAPIKey != nil {
+		candidates = append(candidates, e.IAMAPIKey.Name, e.IAMAPIKey.Key)
+	}
+	if e.IAMRole != nil {
+		candidates = append(candidates, e.IAMRole.Name, e.IAMRole.ID.String())
+	}
+
+	for _, c := range candidates {
+		if c != "" && strings.EqualFold(c, principal) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func eventMatchesResource(e v3.Event, resource string) bool {
+	for _, v := range e.PathParams {
+		if fmt.Sprint(v) == resource {
+			return true
+		}
+	}
+
+	return strings.Contains(e.URI, resource)
+}
+
+// eventTracker keeps track of the events already emitted across successive
+// polls, so that overlapping time windows don't yield duplicate rows.
+type eventTracker struct {
+	last time.Time
+	seen map[string]struct{}
+}
+
+func newEventTracker() *eventTracker {
+	return &eventTracker{seen: make(map[string]struct{})}
+}
+
+// since returns the lower bound of the next poll's time window.
+func (t *eventTracker) since(from time.Time) time.Time {
+	if t.last.IsZero() {
+		return from
+	}
+	return t.last
+}
+
+// fresh returns the events not emitted yet, in chronological order.
+func (t *eventTracker) fresh(events []v3.Event) []v3.Event {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp.Before(events[j].Timestamp)
+	})
+
+	out := make([]v3.Event, 0, len(events))
+	for _, e := range events {
+		if e.Timestamp.Before(t.last) {
+			continue
+		}
+		if _, ok := t.seen[e.RequestID]; ok && e.RequestID != "" {
+			continue
+		}
+
+		if e.Timestamp.After(t.last) {
+			// Only events sharing the most recent timestamp can show up
+			// again in the next poll, forget about the older ones.
+			t.last = e.Timestamp
+			t.seen = make(map[string]struct{})
+		}
+		t.seen[e.RequestID] = struct{}{}
+
+		out = append(out, e)
+	}
+
+	return out
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(eventsCmd, &eventListCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+		Interval:           10,
+	}))
+}
@@ -0,0 +1,142 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/testutils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+func TestEventFilter(t *testing.T) {
+	event := v3.Event{
+		SourceIP:   "192.0.2.10",
+		URI:        "/v2/instance/11111111-1111-1111-1111-111111111111:start",
+		PathParams: map[string]any{"id": "11111111-1111-1111-1111-111111111111"},
+		IAMUser:    &v3.User{Email: "alice@example.net", ID: "22222222-2222-2222-2222-222222222222"},
+	}
+
+	tests := []struct {
+		name      string
+		source    string
+		principal string
+		resource  string
+		want      bool
+	}{
+		{name: "no criteria", want: true},
+		{name: "source IP", source: "192.0.2.10", want: true},
+		{name: "source IP mismatch", source: "192.0.2.11", want: false},
+		{name: "source network", source: "192.0.2.0/24", want: true},
+		{name: "source network mismatch", source: "198.51.100.0/24", want: false},
+		{name: "principal email", principal: "Alice@example.net", want: true},
+		{name: "principal ID", principal: "22222222-2222-2222-2222-222222222222", want: true},
+		{name: "principal mismatch", principal: "bob@example.net", want: false},
+		{name: "resource path param", resource: "11111111-1111-1111-1111-111111111111", want: true},
+		{name: "resource URI fragment", resource: "/v2/instance", want: true},
+		{name: "resource mismatch", resource: "sks-cluster", want: false},
+		{name: "all criteria", source: "192.0.2.10", principal: "alice@example.net", resource: "instance", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := newEventFilter(tt.source, tt.principal, tt.resource)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, filter(event))
+		})
+	}
+
+	_, err := newEventFilter("192.0.2.0/33", "", "")
+	assert.Error(t, err)
+}
+
+func TestEventTracker(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	tracker := newEventTracker()
+
+	first := tracker.fresh([]v3.Event{
+		{RequestID: "b", Timestamp: t0.Add(time.Second)},
+		{RequestID: "a", Timestamp: t0},
+	})
+	require.Len(t, first, 2)
+	assert.Equal(t, "a", first[0].RequestID)
+	assert.Equal(t, t0.Add(time.Second), tracker.since(time.Time{}))
+
+	// The next poll overlaps with the previous one: already emitted events
+	// must be skipped.
+	second := tracker.fresh([]v3.Event{
+		{RequestID: "b", Timestamp: t0.Add(time.Second)},
+		{RequestID: "c", Timestamp: t0.Add(time.Second)},
+		{RequestID: "d", Timestamp: t0.Add(2 * time.Second)},
+	})
+	require.Len(t, second, 2)
+	assert.Equal(t, "c", second[0].RequestID)
+	assert.Equal(t, "d", second[1].RequestID)
+}
+
+func TestRunEventList(t *testing.T) {
+	var query string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/event", func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		testutils.WriteJSON(t, w, http.StatusOK, []v3.Event{
+			{RequestID: "1", SourceIP: "192.0.2.1", Handler: "create-instance", Timestamp: time.Now()},
+			{RequestID: "2", SourceIP: "192.0.2.2", Handler: "delete-instance", Timestamp: time.Now()},
+		})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	testutils.SetupV3Client(t, server.URL)
+	globalstate.OutputFormat = "json"
+	defer func() { globalstate.OutputFormat = "" }()
+
+	var stdout, stderr bytes.Buffer
+	err := runEventList(&eventListCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+		NewerThan:          "1h",
+		Source:             "192.0.2.2",
+	}, &stdout, &stderr)
+	require.NoError(t, err)
+	assert.Contains(t, query, "from=")
+
+	var out []eventListItemOutput
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &out))
+	require.Len(t, out, 1)
+	assert.Equal(t, "delete-instance", out[0].Handler)
+	assert.Empty(t, stderr.String())
+}
+
+func TestRunEventListFollowUnsupportedFlags(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		query string
+		sort  string
+	}{
+		{name: "query", query: "[0]"},
+		{name: "sort-by", sort: "timestamp"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			globalstate.Query, globalstate.SortBy = tt.query, tt.sort
+			defer func() { globalstate.Query, globalstate.SortBy = "", "" }()
+
+			var stdout, stderr bytes.Buffer
+			err := runEventList(&eventListCmd{
+				CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+				Follow:             true,
+				Interval:           5,
+			}, &stdout, &stderr)
+			require.ErrorContains(t, err, "cannot be used in --follow mode")
+			assert.Empty(t, stdout.String())
+		})
+	}
+}
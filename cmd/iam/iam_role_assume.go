@@ -0,0 +1,228 @@
+package iam
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/cmd/config"
+	"github.com/exoscale/cli/pkg/account"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type iamRoleAssumeOutput struct {
+	Name      string `json:"name"`
+	Key       string `json:"key"`
+	Secret    string `json:"secret"`
+	RoleID    string `json:"role-id"`
+	OrgID     string `json:"org-id"`
+	ExpiresAT string `json:"expires-at"`
+}
+
+func (o *iamRoleAssumeOutput) ToJSON()  { output.JSON(o) }
+func (o *iamRoleAssumeOutput) ToText()  { output.Text(o) }
+func (o *iamRoleAssumeOutput) ToTable() { output.Table(o) }
+
+type iamRoleAssumeCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"assume"`
+
+	Role string `cli-arg:"#" cli-usage:"ROLE-NAME|ROLE-ID"`
+
+	Env         bool   `cli-usage:"print the credentials as shell environment variables exports"`
+	Force       bool   `cli-short:"f" cli-usage:"overwrite the configuration account specified with --save-account if it already exists"`
+	SaveAccount string `cli-usage:"save the credentials as a new account of the configuration file under this name"`
+	Shell       bool   `cli-usage:"spawn a subshell ($SHELL) using the credentials"`
+	TTL         int64  `cli-flag:"ttl" cli-usage:"credentials time to live in seconds (cannot exceed the role max session TTL)"`
+}
+
+func (c *iamRoleAssumeCmd) CmdAliases() []string { return nil }
+
+func (c *iamRoleAssumeCmd) CmdShort() string {
+	return "Assume an IAM Role"
+}
+
+func (c *iamRoleAssumeCmd) CmdLong() string {
+	return fmt.Sprintf(`This command requests short-lived API credentials allowing the caller to
+assume an IAM Role. The role must allow the current principal to assume it in
+its assume role policy.
+
+By default the credentials are printed. Alternatively they can be:
+
+  * printed as environment variables exports (--env), e.g.:
+      eval $(exo iam role assume my-role --env)
+  * saved as a new account in the configuration file (--save-account NAME),
+    to be used with "exo --use-account NAME ..."; the account is not removed
+    once the credentials have expired, delete it with "exo config delete NAME"
+  * used in a subshell spawned by the command (--shell); exiting the subshell
+    returns to the original credentials
+
+Because Secret is only printed during the role assumption, --quiet (-Q) flag is
+not implemented for this command.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&iamRoleAssumeOutput{}), ", "))
+}
+
+func (c *iamRoleAssumeCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *iamRoleAssumeCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	modes := 0
+	for _, m := range []bool{c.Env, c.SaveAccount != "", c.Shell} {
+		if m {
+			modes++
+		}
+	}
+	if modes > 1 {
+		return fmt.Errorf("--env, --save-account and --shell are mutually exclusive")
+	}
+
+	if c.TTL <= 0 {
+		return fmt.Errorf("invalid TTL value %d", c.TTL)
+	}
+
+	if c.SaveAccount != "" && !c.Force && account.GAllAccount != nil {
+		for _, a := range account.GAllAccount.Accounts {
+			if a.Name == c.SaveAccount {
+				return fmt.Errorf("account %q already exists, use --force to overwrite it", c.SaveAccount)
+			}
+		}
+	}
+
+	ctx := exocmd.GContext
+	client := globalstate.EgoscaleV3Client
+
+	listIAMRolesResp, err := client.ListIAMRoles(ctx)
+	if err != nil {
+		return err
+	}
+
+	iamRole, err := listIAMRolesResp.FindIAMRole(c.Role)
+	if err != nil {
+		return err
+	}
+
+	creds, err := client.AssumeIAMRole(ctx, iamRole.ID, v3.AssumeIAMRoleRequest{Ttl: c.TTL})
+	if err != nil {
+		return err
+	}
+
+	env := assumedRoleEnv(creds, iamRole.Name)
+
+	switch {
+	case c.Env:
+		writeEnvExports(os.Stdout, env)
+		return nil
+
+	case c.SaveAccount != "":
+		if err := config.SaveAccount(assumedRoleAccount(creds, c.SaveAccount)); err != nil {
+			return fmt.Errorf("unable to save account: %w", err)
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr,
+			"Account %q saved, credentials expire at %s: delete it afterwards with \"exo config delete %s\"\n",
+			c.SaveAccount, creds.ExpiresAT, c.SaveAccount)
+		return nil
+
+	case c.Shell:
+		return spawnAssumedRoleShell(env, iamRole.Name, creds.ExpiresAT)
+	}
+
+	return c.OutputFunc(&iamRoleAssumeOutput{
+		Name:      creds.Name,
+		Key:       creds.Key,
+		Secret:    creds.Secret,
+		RoleID:    creds.RoleID,
+		OrgID:     creds.OrgID,
+		ExpiresAT: creds.ExpiresAT,
+	}, nil)
+}
+
+// assumedRoleEnv returns the environment variables (in a stable order)
+// overriding the CLI credentials with the ones of an assumed role.
+func assumedRoleEnv(creds *v3.AssumeIAMRoleResponse, roleName string) [][2]string {
+	env := [][2]string{
+		{"EXOSCALE_API_KEY", creds.Key},
+		{"EXOSCALE_API_SECRET", creds.Secret},
+	}
+
+	if account.CurrentAccount != nil && account.CurrentAccount.Environment != "" {
+		env = append(env, [2]string{"EXOSCALE_API_ENVIRONMENT", account.CurrentAccount.Environment})
+	}
+
+	return append(env, [2]string{"EXOSCALE_ASSUMED_ROLE", roleName})
+}
+
+// assumedRoleAccount returns a configuration account using the credentials of
+// an assumed role, targeting the same API as the current account.
+func assumedRoleAccount(creds *v3.AssumeIAMRoleResponse, name string) account.Account {
+	acc := account.Account{
+		Name:   name,
+		Key:    creds.Key,
+		Secret: creds.Secret,
+	}
+
+	if account.CurrentAccount != nil {
+		acc.DefaultZone = account.CurrentAccount.DefaultZone
+		acc.Environment = account.CurrentAccount.Environment
+		acc.Endpoint = account.CurrentAccount.Endpoint
+	}
+
+	return acc
+}
+
+func writeEnvExports(w io.Writer, env [][2]string) {
+	for _, kv := range env {
+		_, _ = fmt.Fprintf(w, "export %s=%q\n", kv[0], kv[1])
+	}
+}
+
+func spawnAssumedRoleShell(env [][2]string, roleName, expiresAt string) error {
+	shell := os.Getenv("SHELL")
+	if shell == "" {
+		shell = "/bin/sh"
+	}
+
+	cmd := exec.Command(shell)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+	for _, kv := range env {
+		cmd.Env = append(cmd.Env, kv[0]+"="+kv[1])
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr,
+		"Spawning a shell assuming role %q (credentials expire at %s), exit it to drop the role\n",
+		roleName, expiresAt)
+
+	if err := cmd.Run(); err != nil {
+		// The subshell exit status is the one of the last command run by
+		// the user, it is not relevant to us.
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(iamRoleCmd, &iamRoleAssumeCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+		TTL:                3600,
+	}))
+}
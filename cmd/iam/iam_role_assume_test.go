@@ -0,0 +1,55 @@
+package iam
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/exoscale/cli/pkg/account"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+func TestWriteEnvExports(t *testing.T) {
+	defer func(a *account.Account) { account.CurrentAccount = a }(account.CurrentAccount)
+	account.CurrentAccount = &account.Account{Environment: "api"}
+
+	var buf bytes.Buffer
+	writeEnvExports(&buf, assumedRoleEnv(&v3.AssumeIAMRoleResponse{
+		Key:    "EXOkey",
+		Secret: "s3cr\"et",
+	}, "on-call"))
+
+	want := `export EXOSCALE_API_KEY="EXOkey"
+export EXOSCALE_API_SECRET="s3cr\"et"
+export EXOSCALE_API_ENVIRONMENT="api"
+export EXOSCALE_ASSUMED_ROLE="on-call"
+`
+	if buf.String() != want {
+		t.Errorf("unexpected exports:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestAssumedRoleAccount(t *testing.T) {
+	defer func(a *account.Account) { account.CurrentAccount = a }(account.CurrentAccount)
+	account.CurrentAccount = &account.Account{
+		Name:        "main",
+		Key:         "EXOmain",
+		Secret:      "main-secret",
+		DefaultZone: "de-fra-1",
+		Environment: "ppapi",
+		Endpoint:    "https://ppapi-de-fra-1.exoscale.com/v2",
+	}
+
+	got := assumedRoleAccount(&v3.AssumeIAMRoleResponse{Key: "EXOkey", Secret: "secret"}, "on-call")
+	want := account.Account{
+		Name:        "on-call",
+		Key:         "EXOkey",
+		Secret:      "secret",
+		DefaultZone: "de-fra-1",
+		Environment: "ppapi",
+		Endpoint:    "https://ppapi-de-fra-1.exoscale.com/v2",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected account:\n%+v\nwant:\n%+v", got, want)
+	}
+}
@@ -10,14 +10,16 @@ import (
 	"github.com/exoscale/cli/pkg/account"
 	"github.com/exoscale/cli/pkg/globalstate"
 	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/selector"
 	"github.com/exoscale/cli/utils"
 	v3 "github.com/exoscale/egoscale/v3"
 )
 
 type iamRoleListItemOutput struct {
-	ID       string `json:"key"`
-	Name     string `json:"name"`
-	Editable bool   `json:"type"`
+	ID       string            `json:"key"`
+	Name     string            `json:"name"`
+	Editable bool              `json:"type"`
+	Labels   map[string]string `json:"labels,omitempty" output:"-"`
 }
 
 type iamRoleListOutput []iamRoleListItemOutput
@@ -30,6 +32,8 @@ type iamRoleListCmd struct {
 	exocmd.CliCommandSettings `cli-cmd:"-"`
 
 	_ bool `cli-cmd:"list"`
+
+	Selector string `cli-short:"l" cli-usage:"label selector to filter results with, e.g. \"env=prod,tier in (web,api)\""`
 }
 
 func (c *iamRoleListCmd) CmdAliases() []string { return exocmd.GListAlias }
@@ -48,6 +52,11 @@ func (c *iamRoleListCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
 }
 
 func (c *iamRoleListCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	sel, err := selector.Parse(c.Selector)
+	if err != nil {
+		return err
+	}
+
 	ctx := exocmd.GContext
 	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(account.CurrentAccount.DefaultZone))
 	if err != nil {
@@ -62,10 +71,15 @@ func (c *iamRoleListCmd) CmdRun(_ *cobra.Command, _ []string) error {
 	out := make(iamRoleListOutput, 0)
 
 	for _, role := range iamRoles.IAMRoles {
+		if !sel.Matches(role.Labels) {
+			continue
+		}
+
 		out = append(out, iamRoleListItemOutput{
 			ID:       role.ID.String(),
 			Name:     role.Name,
 			Editable: utils.DefaultBool(role.Editable, false),
+			Labels:   role.Labels,
 		})
 	}
 
@@ -0,0 +1,64 @@
+package iam
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+var iamUserCmd = &cobra.Command{
+	Use:   "user",
+	Short: "Organization users management",
+}
+
+func init() {
+	iamCmd.AddCommand(iamUserCmd)
+}
+
+type iamUserShowOutput struct {
+	ID                      v3.UUID `json:"id"`
+	Email                   string  `json:"email"`
+	Role                    string  `json:"role"`
+	Pending                 bool    `json:"pending"`
+	SSO                     bool    `json:"sso"`
+	TwoFactorAuthentication bool    `json:"two-factor-authentication"`
+}
+
+func (o *iamUserShowOutput) ToJSON()  { output.JSON(o) }
+func (o *iamUserShowOutput) ToText()  { output.Text(o) }
+func (o *iamUserShowOutput) ToTable() { output.Table(o) }
+
+func iamUserOutput(user v3.User) iamUserShowOutput {
+	out := iamUserShowOutput{
+		ID:                      user.ID,
+		Email:                   user.Email,
+		Pending:                 utils.DefaultBool(user.Pending, false),
+		SSO:                     utils.DefaultBool(user.Sso, false),
+		TwoFactorAuthentication: utils.DefaultBool(user.TwoFactorAuthentication, false),
+	}
+
+	if user.Role != nil {
+		out.Role = user.Role.Name
+		if out.Role == "" {
+			out.Role = user.Role.ID.String()
+		}
+	}
+
+	return out
+}
+
+// findIAMUser looks up an organization user by email address or ID.
+func findIAMUser(resp *v3.ListUsersResponse, emailOrID string) (v3.User, error) {
+	for _, user := range resp.Users {
+		if user.ID.String() == emailOrID || strings.EqualFold(user.Email, emailOrID) {
+			return user, nil
+		}
+	}
+
+	return v3.User{}, fmt.Errorf("user %q not found: %w", emailOrID, v3.ErrNotFound)
+}
@@ -0,0 +1,77 @@
+package iam
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type iamUserDeleteCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"delete"`
+
+	User string `cli-arg:"#" cli-usage:"EMAIL|ID"`
+
+	Force bool `cli-short:"f" cli-usage:"don't prompt for confirmation"`
+}
+
+func (c *iamUserDeleteCmd) CmdAliases() []string { return exocmd.GDeleteAlias }
+
+func (c *iamUserDeleteCmd) CmdShort() string {
+	return "Remove a user from the organization"
+}
+
+func (c *iamUserDeleteCmd) CmdLong() string {
+	return `This command removes a user from the organization.`
+}
+
+func (c *iamUserDeleteCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *iamUserDeleteCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client := globalstate.EgoscaleV3Client
+
+	listUsersResp, err := client.ListUsers(ctx)
+	if err != nil {
+		return err
+	}
+
+	user, err := findIAMUser(listUsersResp, c.User)
+	if err != nil {
+		return err
+	}
+
+	if !c.Force {
+		if !utils.AskQuestion(ctx, fmt.Sprintf("Are you sure you want to remove user %q from the organization?", user.Email)) {
+			return nil
+		}
+	}
+
+	return utils.DecorateAsyncOperations(fmt.Sprintf("Deleting user %s...", c.User), func() error {
+		op, err := client.DeleteUser(ctx, user.ID)
+		if err != nil {
+			return fmt.Errorf("exoscale: error while deleting user: %w", err)
+		}
+
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+		if err != nil {
+			return fmt.Errorf("exoscale: error while waiting for user deletion: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(iamUserCmd, &iamUserDeleteCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,100 @@
+package iam
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type iamUserInviteCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"invite"`
+
+	Email string `cli-arg:"#" cli-usage:"EMAIL"`
+	Role  string `cli-arg:"?" cli-usage:"ROLE-NAME|ROLE-ID"`
+}
+
+func (c *iamUserInviteCmd) CmdAliases() []string { return exocmd.GCreateAlias }
+
+func (c *iamUserInviteCmd) CmdShort() string {
+	return "Invite a user to the organization"
+}
+
+func (c *iamUserInviteCmd) CmdLong() string {
+	return fmt.Sprintf(`This command invites a new user to the organization, optionally assigning
+them an IAM Role.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&iamUserShowOutput{}), ", "))
+}
+
+func (c *iamUserInviteCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *iamUserInviteCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client := globalstate.EgoscaleV3Client
+
+	createUserReq := v3.CreateUserRequest{
+		Email: c.Email,
+	}
+
+	if c.Role != "" {
+		listIAMRolesResp, err := client.ListIAMRoles(ctx)
+		if err != nil {
+			return err
+		}
+
+		iamRole, err := listIAMRolesResp.FindIAMRole(c.Role)
+		if err != nil {
+			return err
+		}
+
+		createUserReq.Role = &v3.IAMRole{ID: iamRole.ID}
+	}
+
+	op, err := client.CreateUser(ctx, createUserReq)
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Inviting user %s...", c.Email), func() {
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if globalstate.Quiet {
+		return nil
+	}
+
+	listUsersResp, err := client.ListUsers(ctx)
+	if err != nil {
+		return err
+	}
+
+	user, err := findIAMUser(listUsersResp, c.Email)
+	if err != nil {
+		return err
+	}
+
+	out := iamUserOutput(user)
+
+	return c.OutputFunc(&out, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(iamUserCmd, &iamUserInviteCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
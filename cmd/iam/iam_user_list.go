@@ -0,0 +1,63 @@
+package iam
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+)
+
+type iamUserListOutput []iamUserShowOutput
+
+func (o *iamUserListOutput) ToJSON()  { output.JSON(o) }
+func (o *iamUserListOutput) ToText()  { output.Text(o) }
+func (o *iamUserListOutput) ToTable() { output.Table(o) }
+
+type iamUserListCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"list"`
+}
+
+func (c *iamUserListCmd) CmdAliases() []string { return exocmd.GListAlias }
+
+func (c *iamUserListCmd) CmdShort() string { return "List organization users" }
+
+func (c *iamUserListCmd) CmdLong() string {
+	return fmt.Sprintf(`This command lists all the users of the organization.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&iamUserShowOutput{}), ", "))
+}
+
+func (c *iamUserListCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *iamUserListCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client := globalstate.EgoscaleV3Client
+
+	listUsersResp, err := client.ListUsers(ctx)
+	if err != nil {
+		return err
+	}
+
+	out := make(iamUserListOutput, 0)
+
+	for _, user := range listUsersResp.Users {
+		out = append(out, iamUserOutput(user))
+	}
+
+	return c.OutputFunc(&out, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(iamUserCmd, &iamUserListCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,94 @@
+package iam
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type iamUserSetRoleCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"set-role"`
+
+	User string `cli-arg:"#" cli-usage:"EMAIL|ID"`
+	Role string `cli-arg:"#" cli-usage:"ROLE-NAME|ROLE-ID"`
+}
+
+func (c *iamUserSetRoleCmd) CmdAliases() []string { return nil }
+
+func (c *iamUserSetRoleCmd) CmdShort() string {
+	return "Set the IAM Role of an organization user"
+}
+
+func (c *iamUserSetRoleCmd) CmdLong() string {
+	return fmt.Sprintf(`This command assigns an IAM Role to an organization user.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&iamUserShowOutput{}), ", "))
+}
+
+func (c *iamUserSetRoleCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *iamUserSetRoleCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+	client := globalstate.EgoscaleV3Client
+
+	listUsersResp, err := client.ListUsers(ctx)
+	if err != nil {
+		return err
+	}
+
+	user, err := findIAMUser(listUsersResp, c.User)
+	if err != nil {
+		return err
+	}
+
+	listIAMRolesResp, err := client.ListIAMRoles(ctx)
+	if err != nil {
+		return err
+	}
+
+	iamRole, err := listIAMRolesResp.FindIAMRole(c.Role)
+	if err != nil {
+		return err
+	}
+
+	op, err := client.UpdateUserRole(ctx, user.ID, v3.UpdateUserRoleRequest{
+		Role: &v3.IAMRole{ID: iamRole.ID},
+	})
+	if err != nil {
+		return err
+	}
+
+	utils.DecorateAsyncOperation(fmt.Sprintf("Setting role of user %s...", c.User), func() {
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	})
+	if err != nil {
+		return err
+	}
+
+	if globalstate.Quiet {
+		return nil
+	}
+
+	user.Role = &iamRole
+	out := iamUserOutput(user)
+
+	return c.OutputFunc(&out, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(iamUserCmd, &iamUserSetRoleCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
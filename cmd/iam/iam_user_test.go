@@ -0,0 +1,29 @@
+package iam
+
+import (
+	"errors"
+	"testing"
+
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+func TestFindIAMUser(t *testing.T) {
+	resp := &v3.ListUsersResponse{Users: []v3.User{
+		{ID: "11111111-1111-1111-1111-111111111111", Email: "alice@example.net"},
+		{ID: "22222222-2222-2222-2222-222222222222", Email: "bob@example.net"},
+	}}
+
+	for _, query := range []string{"bob@example.net", "Bob@Example.net", "22222222-2222-2222-2222-222222222222"} {
+		user, err := findIAMUser(resp, query)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", query, err)
+		}
+		if user.Email != "bob@example.net" {
+			t.Errorf("%s: got user %q, want bob@example.net", query, user.Email)
+		}
+	}
+
+	if _, err := findIAMUser(resp, "carol@example.net"); !errors.Is(err, v3.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
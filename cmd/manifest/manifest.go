@@ -0,0 +1,391 @@
+// Package manifest implements the "exo plan" and "exo apply" commands, which
+// reconcile the Exoscale resources described in a declarative manifest with
+// the live state of an organization, and the "exo export" command snapshotting
+// this live state.
+package manifest
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	exocmd "github.com/exoscale/cli/cmd"
+)
+
+const manifestStateAbsent = "absent"
+
+// manifest describes a set of Exoscale resources of a zone. It is expressed
+// in YAML, or in JSON since YAML is a superset of it.
+type manifest struct {
+	Zone               string                      `yaml:"zone,omitempty" json:"zone,omitempty"`
+	SecurityGroups     []manifestSecurityGroup     `yaml:"security-groups,omitempty" json:"security-groups,omitempty"`
+	PrivateNetworks    []manifestPrivateNetwork    `yaml:"private-networks,omitempty" json:"private-networks,omitempty"`
+	AntiAffinityGroups []manifestAntiAffinityGroup `yaml:"anti-affinity-groups,omitempty" json:"anti-affinity-groups,omitempty"`
+	Instances          []manifestInstance          `yaml:"instances,omitempty" json:"instances,omitempty"`
+	InstancePools      []manifestInstancePool      `yaml:"instance-pools,omitempty" json:"instance-pools,omitempty"`
+	LoadBalancers      []manifestLoadBalancer      `yaml:"load-balancers,omitempty" json:"load-balancers,omitempty"`
+	DNSDomains         []manifestDNSDomain         `yaml:"dns-domains,omitempty" json:"dns-domains,omitempty"`
+}
+
+type manifestSecurityGroup struct {
+	Name        string                      `yaml:"name" json:"name"`
+	State       string                      `yaml:"state,omitempty" json:"state,omitempty"`
+	Description string                      `yaml:"description,omitempty" json:"description,omitempty"`
+	Rules       []manifestSecurityGroupRule `yaml:"rules,omitempty" json:"rules,omitempty"`
+}
+
+type manifestSecurityGroupRule struct {
+	Description         string `yaml:"description,omitempty" json:"description,omitempty"`
+	Flow                string `yaml:"flow,omitempty" json:"flow,omitempty"`
+	Protocol            string `yaml:"protocol,omitempty" json:"protocol,omitempty"`
+	Port                string `yaml:"port,omitempty" json:"port,omitempty"`
+	Network             string `yaml:"network,omitempty" json:"network,omitempty"`
+	SecurityGroup       string `yaml:"security-group,omitempty" json:"security-group,omitempty"`
+	PublicSecurityGroup string `yaml:"public-security-group,omitempty" json:"public-security-group,omitempty"`
+	ICMPType            *int64 `yaml:"icmp-type,omitempty" json:"icmp-type,omitempty"`
+	ICMPCode            *int64 `yaml:"icmp-code,omitempty" json:"icmp-code,omitempty"`
+}
+
+type manifestPrivateNetwork struct {
+	Name        string            `yaml:"name" json:"name"`
+	State       string            `yaml:"state,omitempty" json:"state,omitempty"`
+	Description string            `yaml:"description,omitempty" json:"description,omitempty"`
+	StartIP     string            `yaml:"start-ip,omitempty" json:"start-ip,omitempty"`
+	EndIP       string            `yaml:"end-ip,omitempty" json:"end-ip,omitempty"`
+	Netmask     string            `yaml:"netmask,omitempty" json:"netmask,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty" json:"labels,omitempty"`
+}
+
+type manifestAntiAffinityGroup struct {
+	Name        string `yaml:"name" json:"name"`
+	State       string `yaml:"state,omitempty" json:"state,omitempty"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+}
+
+type manifestInstance struct {
+	Name               string            `yaml:"name" json:"name"`
+	State              string            `yaml:"state,omitempty" json:"state,omitempty"`
+	InstanceType       string            `yaml:"instance-type,omitempty" json:"instance-type,omitempty"`
+	Template           string            `yaml:"template,omitempty" json:"template,omitempty"`
+	TemplateVisibility string            `yaml:"template-visibility,omitempty" json:"template-visibility,omitempty"`
+	DiskSize           int64             `yaml:"disk-size,omitempty" json:"disk-size,omitempty"`
+	SSHKeys            []string          `yaml:"ssh-keys,omitempty" json:"ssh-keys,omitempty"`
+	SecurityGroups     []string          `yaml:"security-groups,omitempty" json:"security-groups,omitempty"`
+	PrivateNetworks    []string          `yaml:"private-networks,omitempty" json:"private-networks,omitempty"`
+	AntiAffinityGroups []string          `yaml:"anti-affinity-groups,omitempty" json:"anti-affinity-groups,omitempty"`
+	Labels             map[string]string `yaml:"labels,omitempty" json:"labels,omitempty"`
+}
+
+type manifestInstancePool struct {
+	Name               string            `yaml:"name" json:"name"`
+	State              string            `yaml:"state,omitempty" json:"state,omitempty"`
+	Description        string            `yaml:"description,omitempty" json:"description,omitempty"`
+	Size               int64             `yaml:"size,omitempty" json:"size,omitempty"`
+	InstanceType       string            `yaml:"instance-type,omitempty" json:"instance-type,omitempty"`
+	InstancePrefix     string            `yaml:"instance-prefix,omitempty" json:"instance-prefix,omitempty"`
+	Template           string            `yaml:"template,omitempty" json:"template,omitempty"`
+	TemplateVisibility string            `yaml:"template-visibility,omitempty" json:"template-visibility,omitempty"`
+	DiskSize           int64             `yaml:"disk-size,omitempty" json:"disk-size,omitempty"`
+	SSHKeys            []string          `yaml:"ssh-keys,omitempty" json:"ssh-keys,omitempty"`
+	SecurityGroups     []string          `yaml:"security-groups,omitempty" json:"security-groups,omitempty"`
+	PrivateNetworks    []string          `yaml:"private-networks,omitempty" json:"private-networks,omitempty"`
+	AntiAffinityGroups []string          `yaml:"anti-affinity-groups,omitempty" json:"anti-affinity-groups,omitempty"`
+	Labels             map[string]string `yaml:"labels,omitempty" json:"labels,omitempty"`
+}
+
+type manifestLoadBalancer struct {
+	Name        string                        `yaml:"name" json:"name"`
+	State       string                        `yaml:"state,omitempty" json:"state,omitempty"`
+	Description string                        `yaml:"description,omitempty" json:"description,omitempty"`
+	Labels      map[string]string             `yaml:"labels,omitempty" json:"labels,omitempty"`
+	Services    []manifestLoadBalancerService `yaml:"services,omitempty" json:"services,omitempty"`
+}
+
+type manifestLoadBalancerService struct {
+	Name         string                          `yaml:"name" json:"name"`
+	Description  string                          `yaml:"description,omitempty" json:"description,omitempty"`
+	InstancePool string                          `yaml:"instance-pool" json:"instance-pool"`
+	Port         int64                           `yaml:"port" json:"port"`
+	TargetPort   int64                           `yaml:"target-port" json:"target-port"`
+	Protocol     string                          `yaml:"protocol,omitempty" json:"protocol,omitempty"`
+	Strategy     string                          `yaml:"strategy,omitempty" json:"strategy,omitempty"`
+	Healthcheck  manifestLoadBalancerHealthcheck `yaml:"healthcheck,omitempty" json:"healthcheck,omitempty"`
+}
+
+type manifestLoadBalancerHealthcheck struct {
+	Mode     string `yaml:"mode,omitempty" json:"mode,omitempty"`
+	Port     int64  `yaml:"port,omitempty" json:"port,omitempty"`
+	URI      string `yaml:"uri,omitempty" json:"uri,omitempty"`
+	Interval int64  `yaml:"interval,omitempty" json:"interval,omitempty"`
+	Timeout  int64  `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+	Retries  int64  `yaml:"retries,omitempty" json:"retries,omitempty"`
+	TLSSNI   string `yaml:"tls-sni,omitempty" json:"tls-sni,omitempty"`
+}
+
+type manifestDNSDomain struct {
+	Name    string              `yaml:"name" json:"name"`
+	State   string              `yaml:"state,omitempty" json:"state,omitempty"`
+	Records []manifestDNSRecord `yaml:"records,omitempty" json:"records,omitempty"`
+}
+
+type manifestDNSRecord struct {
+	Name     string `yaml:"name" json:"name"`
+	Type     string `yaml:"type" json:"type"`
+	Content  string `yaml:"content" json:"content"`
+	TTL      int64  `yaml:"ttl,omitempty" json:"ttl,omitempty"`
+	Priority int64  `yaml:"priority,omitempty" json:"priority,omitempty"`
+}
+
+// loadManifest reads a manifest from the file specified, or from the
+// standard input if path is "-".
+func loadManifest(path string) (*manifest, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseManifest(data)
+}
+
+// parseManifest decodes and validates a manifest, and sets the default
+// values of the optional settings.
+func parseManifest(data []byte) (*manifest, error) {
+	var m manifest
+	if err := yaml.UnmarshalStrict(data, &m); err != nil {
+		return nil, fmt.Errorf("unable to parse manifest: %w", err)
+	}
+
+	if err := m.validate(); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	return &m, nil
+}
+
+func (m *manifest) validate() error { //nolint:gocyclo
+	names := map[string][]string{}
+	checkResource := func(kind, name, state string) error {
+		if name == "" {
+			return fmt.Errorf("%s: name must be specified", kind)
+		}
+		if slices.Contains(names[kind], name) {
+			return fmt.Errorf("%s %q: declared more than once", kind, name)
+		}
+		names[kind] = append(names[kind], name)
+		if state != "" && state != manifestStateAbsent {
+			return fmt.Errorf("%s %q: invalid state %q (supported: %s)", kind, name, state, manifestStateAbsent)
+		}
+		return nil
+	}
+
+	for i := range m.SecurityGroups {
+		sg := &m.SecurityGroups[i]
+		if err := checkResource(manifestKindSecurityGroup, sg.Name, sg.State); err != nil {
+			return err
+		}
+		for j := range sg.Rules {
+			if err := sg.Rules[j].validate(); err != nil {
+				return fmt.Errorf("%s %q: rule #%d: %w", manifestKindSecurityGroup, sg.Name, j+1, err)
+			}
+		}
+	}
+
+	for _, pn := range m.PrivateNetworks {
+		if err := checkResource(manifestKindPrivateNetwork, pn.Name, pn.State); err != nil {
+			return err
+		}
+		for _, ip := range []string{pn.StartIP, pn.EndIP, pn.Netmask} {
+			if ip != "" && net.ParseIP(ip) == nil {
+				return fmt.Errorf("%s %q: invalid IP address %q", manifestKindPrivateNetwork, pn.Name, ip)
+			}
+		}
+	}
+
+	for _, aag := range m.AntiAffinityGroups {
+		if err := checkResource(manifestKindAntiAffinityGroup, aag.Name, aag.State); err != nil {
+			return err
+		}
+	}
+
+	for i := range m.Instances {
+		instance := &m.Instances[i]
+		if err := checkResource(manifestKindInstance, instance.Name, instance.State); err != nil {
+			return err
+		}
+		if instance.TemplateVisibility == "" {
+			instance.TemplateVisibility = exocmd.DefaultTemplateVisibility
+		}
+	}
+
+	for i := range m.InstancePools {
+		pool := &m.InstancePools[i]
+		if err := checkResource(manifestKindInstancePool, pool.Name, pool.State); err != nil {
+			return err
+		}
+		if pool.State != manifestStateAbsent && pool.Size <= 0 {
+			return fmt.Errorf("%s %q: size must be greater than 0", manifestKindInstancePool, pool.Name)
+		}
+		if pool.TemplateVisibility == "" {
+			pool.TemplateVisibility = exocmd.DefaultTemplateVisibility
+		}
+	}
+
+	for i := range m.LoadBalancers {
+		lb := &m.LoadBalancers[i]
+		if err := checkResource(manifestKindLoadBalancer, lb.Name, lb.State); err != nil {
+			return err
+		}
+		var services []string
+		for j := range lb.Services {
+			svc := &lb.Services[j]
+			if svc.Name == "" {
+				return fmt.Errorf("%s %q: service #%d: name must be specified", manifestKindLoadBalancer, lb.Name, j+1)
+			}
+			if slices.Contains(services, svc.Name) {
+				return fmt.Errorf("%s %q: service %q declared more than once", manifestKindLoadBalancer, lb.Name, svc.Name)
+			}
+			services = append(services, svc.Name)
+			if err := svc.validate(); err != nil {
+				return fmt.Errorf("%s %q: service %q: %w", manifestKindLoadBalancer, lb.Name, svc.Name, err)
+			}
+		}
+	}
+
+	for i := range m.DNSDomains {
+		domain := &m.DNSDomains[i]
+		if err := checkResource(manifestKindDNSDomain, domain.Name, domain.State); err != nil {
+			return err
+		}
+		for j := range domain.Records {
+			record := &domain.Records[j]
+			if record.Type == "" || record.Content == "" {
+				return fmt.Errorf("%s %q: record #%d: type and content must be specified", manifestKindDNSDomain, domain.Name, j+1)
+			}
+			record.Type = strings.ToUpper(record.Type)
+		}
+	}
+
+	return nil
+}
+
+func (r *manifestSecurityGroupRule) validate() error {
+	if r.Flow == "" {
+		r.Flow = "ingress"
+	}
+	if r.Flow != "ingress" && r.Flow != "egress" {
+		return fmt.Errorf("invalid flow %q (supported: ingress, egress)", r.Flow)
+	}
+
+	r.Protocol = strings.ToLower(r.Protocol)
+	if r.Protocol == "" {
+		r.Protocol = "tcp"
+	}
+	if !slices.Contains(manifestSecurityGroupRuleProtocols, r.Protocol) {
+		return fmt.Errorf("unsupported network protocol %q", r.Protocol)
+	}
+
+	targets := 0
+	for _, v := range []string{r.Network, r.SecurityGroup, r.PublicSecurityGroup} {
+		if v != "" {
+			targets++
+		}
+	}
+	if targets != 1 {
+		return fmt.Errorf("either a target network address or Security Group name must be specified")
+	}
+
+	if r.Network != "" {
+		_, network, err := net.ParseCIDR(r.Network)
+		if err != nil {
+			return fmt.Errorf("invalid value for network %q: %w", r.Network, err)
+		}
+		r.Network = network.String()
+	}
+
+	if (r.Protocol == "tcp" || r.Protocol == "udp") && r.Port == "" {
+		return fmt.Errorf("a port must be specified for tcp or udp protocol")
+	}
+	if _, _, err := r.ports(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ports returns the start and end ports of a rule, parsed from its
+// "PORT" or "START-END" port specification.
+func (r *manifestSecurityGroupRule) ports() (int64, int64, error) {
+	if r.Port == "" {
+		return 0, 0, nil
+	}
+
+	start, end, found := strings.Cut(r.Port, "-")
+	if !found {
+		end = start
+	}
+
+	s, err := strconv.ParseInt(start, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port value %q", r.Port)
+	}
+	e, err := strconv.ParseInt(end, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port value %q", r.Port)
+	}
+	if s < 1 || e > 65535 || e < s {
+		return 0, 0, fmt.Errorf("invalid port range %q", r.Port)
+	}
+
+	return s, e, nil
+}
+
+func (s *manifestLoadBalancerService) validate() error {
+	if s.InstancePool == "" {
+		return fmt.Errorf("instance-pool must be specified")
+	}
+	if s.Port <= 0 || s.TargetPort <= 0 {
+		return fmt.Errorf("port and target-port must be specified")
+	}
+	if s.Protocol == "" {
+		s.Protocol = "tcp"
+	}
+	if s.Strategy == "" {
+		s.Strategy = "round-robin"
+	}
+	if s.Healthcheck.Mode == "" {
+		s.Healthcheck.Mode = "tcp"
+	}
+	if s.Healthcheck.Port == 0 {
+		s.Healthcheck.Port = s.TargetPort
+	}
+	if s.Healthcheck.Interval == 0 {
+		s.Healthcheck.Interval = 10
+	}
+	if s.Healthcheck.Timeout == 0 {
+		s.Healthcheck.Timeout = 5
+	}
+	if s.Healthcheck.Retries == 0 {
+		s.Healthcheck.Retries = 1
+	}
+	if strings.HasPrefix(s.Healthcheck.Mode, "http") && s.Healthcheck.URI == "" {
+		return fmt.Errorf("healthcheck uri must be specified in %s mode", s.Healthcheck.Mode)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package manifest
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+)
+
+var manifestActionProgress = map[string]string{
+	manifestActionCreate: "Creating",
+	manifestActionUpdate: "Updating",
+	manifestActionDelete: "Deleting",
+}
+
+type applyCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"apply"`
+
+	File  string `cli-short:"f" cli-usage:"path to the manifest file (\"-\" to read from standard input)"`
+	Force bool   `cli-usage:"don't prompt for confirmation"`
+	Zone  string `cli-short:"z" cli-usage:"zone (overrides the manifest zone)"`
+}
+
+func (c *applyCmd) CmdAliases() []string { return nil }
+
+func (c *applyCmd) CmdShort() string {
+	return "Create, update or delete resources to match a manifest"
+}
+
+func (c *applyCmd) CmdLong() string {
+	return fmt.Sprintf(`This command reconciles the live state of the resources described in a
+manifest with it, performing the changes shown by "exo plan" in dependency
+order. The planned changes are displayed and confirmed before being applied,
+unless the --force flag is set (required when reading the manifest from
+standard input).
+
+%s
+
+Supported output template annotations: %s`,
+		manifestFormatHelp,
+		strings.Join(output.TemplateAnnotations(&manifestPlanItemOutput{}), ", "))
+}
+
+func (c *applyCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *applyCmd) CmdRun(cmd *cobra.Command, _ []string) error {
+	if c.File == "" {
+		exocmd.CmdExitOnUsageError(cmd, "no manifest file specified")
+	}
+	if c.File == "-" && !c.Force {
+		exocmd.CmdExitOnUsageError(cmd, "--force is required when reading the manifest from standard input")
+	}
+	if globalstate.Async {
+		// Changes are applied in dependency order, each one requiring the
+		// previous ones to be completed.
+		return fmt.Errorf("--async is not supported by this command")
+	}
+
+	ctx := exocmd.GContext
+	s, changes, err := planManifestFile(ctx, c.File, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		if !globalstate.Quiet {
+			fmt.Println("No changes: the live state matches the manifest.")
+		}
+		return nil
+	}
+
+	// Drifts can't be applied, they are only reported.
+	applicable := slices.DeleteFunc(slices.Clone(changes), func(c *manifestChange) bool { return c.apply == nil })
+
+	if !c.Force {
+		if err := c.OutputFunc(newManifestPlanOutput(changes), nil); err != nil {
+			return err
+		}
+	} else {
+		for _, change := range changes {
+			if change.apply == nil {
+				fmt.Fprintf(os.Stderr, "warning: %s %q: not reconciled: %s\n",
+					change.Kind, change.Name, strings.Join(change.Details, ", "))
+			}
+		}
+	}
+
+	if len(applicable) == 0 {
+		if !globalstate.Quiet {
+			fmt.Println("No changes to apply: the remaining differences can't be reconciled in place.")
+		}
+		return nil
+	}
+
+	if !c.Force {
+		if !utils.AskQuestion(ctx, fmt.Sprintf("Are you sure you want to apply these %d change(s)?", len(applicable))) {
+			return nil
+		}
+	}
+
+	for _, change := range applicable {
+		utils.DecorateAsyncOperation(
+			fmt.Sprintf("%s %s %q...", manifestActionProgress[change.Action], change.Kind, change.Name),
+			func() {
+				if err = change.apply(ctx, s); err == nil && len(change.reload) > 0 {
+					err = s.load(ctx, change.reload...)
+				}
+			})
+		if err != nil {
+			return fmt.Errorf("unable to %s %s %q: %w", change.Action, change.Kind, change.Name, err)
+		}
+	}
+
+	if !globalstate.Quiet {
+		fmt.Printf("Applied %d change(s).\n", len(applicable))
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(exocmd.RootCmd, &applyCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,753 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/account"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/storage/sos"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+const (
+	manifestKindSKSCluster  = "sks-cluster"
+	manifestKindSKSNodepool = "sks-nodepool"
+	manifestKindBucket      = "bucket"
+)
+
+// exportKinds lists the kinds of resources supported by "exo export", in
+// the order of the exported documents.
+var exportKinds = []string{
+	manifestKindSecurityGroup,
+	manifestKindPrivateNetwork,
+	manifestKindAntiAffinityGroup,
+	manifestKindInstance,
+	manifestKindInstancePool,
+	manifestKindLoadBalancer,
+	manifestKindSKSCluster,
+	manifestKindSKSNodepool,
+	manifestKindDNSDomain,
+	manifestKindBucket,
+}
+
+// exportDocument describes a single exported resource.
+type exportDocument struct {
+	Kind string `json:"kind"`
+	Zone string `json:"zone,omitempty"`
+	ID   string `json:"id,omitempty"`
+	Spec any    `json:"spec"`
+}
+
+type exportInstance struct {
+	manifestInstance
+
+	ElasticIPs []string `json:"elastic-ips,omitempty"`
+}
+
+type exportSKSCluster struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Version     string            `json:"version,omitempty"`
+	Level       string            `json:"service-level,omitempty"`
+	CNI         string            `json:"cni,omitempty"`
+	AutoUpgrade bool              `json:"auto-upgrade,omitempty"`
+	Addons      []string          `json:"addons,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+type exportSKSNodepool struct {
+	Cluster            string            `json:"cluster"`
+	Name               string            `json:"name"`
+	Description        string            `json:"description,omitempty"`
+	Size               int64             `json:"size"`
+	InstanceType       string            `json:"instance-type,omitempty"`
+	InstancePrefix     string            `json:"instance-prefix,omitempty"`
+	DiskSize           int64             `json:"disk-size,omitempty"`
+	SecurityGroups     []string          `json:"security-groups,omitempty"`
+	PrivateNetworks    []string          `json:"private-networks,omitempty"`
+	AntiAffinityGroups []string          `json:"anti-affinity-groups,omitempty"`
+	Labels             map[string]string `json:"labels,omitempty"`
+	Taints             map[string]string `json:"taints,omitempty"`
+	Addons             []string          `json:"addons,omitempty"`
+}
+
+type exportOutput []exportDocument
+
+func (o *exportOutput) ToJSON() { output.JSON(o) }
+func (o *exportOutput) ToText() { o.ToYAML() }
+
+// ToTable outputs the documents as YAML, since a table cannot convey the
+// nested settings of the resources.
+func (o *exportOutput) ToTable() { o.ToYAML() }
+
+// ToYAML outputs the documents as a YAML stream, one document per resource.
+func (o *exportOutput) ToYAML() {
+	for _, doc := range *o {
+		data, err := output.MarshalYAML(doc)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: unable to encode output to YAML: %s\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("---\n%s", data)
+	}
+}
+
+type exportCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"export"`
+
+	Kind []string `cli-short:"k" cli-usage:"kind of resources to export (can be repeated or comma-separated; default: all)"`
+	Zone string   `cli-short:"z" cli-usage:"zone to export (default: all zones)"`
+}
+
+func (c *exportCmd) CmdAliases() []string { return nil }
+
+func (c *exportCmd) CmdShort() string {
+	return "Export the live state of resources"
+}
+
+func (c *exportCmd) CmdLong() string {
+	return fmt.Sprintf(`This command exports the live state of the resources of all zones (or of
+the zone specified) as normalized documents, one per resource, sorted by
+kind, zone and name so that successive exports can be compared.
+
+The documents are output as a YAML stream, or as a JSON list when the
+output format is "json". Their specs share the attributes of the "exo apply"
+manifest format. DNS domains are global resources, and are exported without
+zone.
+
+Supported kinds: %s`,
+		strings.Join(exportKinds, ", "))
+}
+
+func (c *exportCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *exportCmd) CmdRun(cmd *cobra.Command, _ []string) error {
+	kinds := exportKinds
+	if len(c.Kind) > 0 {
+		kinds = c.Kind
+		for _, kind := range kinds {
+			if !slices.Contains(exportKinds, kind) {
+				exocmd.CmdExitOnUsageError(cmd, fmt.Sprintf("unsupported kind %q (supported: %s)",
+					kind, strings.Join(exportKinds, ", ")))
+			}
+		}
+	}
+
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(account.CurrentAccount.DefaultZone))
+	if err != nil {
+		return err
+	}
+
+	zones, err := utils.AllZonesV3(ctx, client, v3.ZoneName(c.Zone))
+	if err != nil {
+		return err
+	}
+
+	out, failed, err := export(ctx, client, zones, kinds)
+	if err != nil {
+		return err
+	}
+
+	if err := c.OutputFunc(&out, nil); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d zone(s) failed", failed)
+	}
+
+	return nil
+}
+
+// export retrieves the resources of the kinds specified in the zones
+// specified, and returns their documents along with the number of zones
+// that failed.
+func export(ctx context.Context, client *v3.Client, zones []v3.Zone, kinds []string) (exportOutput, int, error) {
+	var (
+		mu  sync.Mutex
+		out exportOutput
+	)
+	add := func(docs ...exportDocument) {
+		mu.Lock()
+		defer mu.Unlock()
+		out = append(out, docs...)
+	}
+
+	var buckets []v3.SOSBucketUsage
+	if slices.Contains(kinds, manifestKindBucket) {
+		res, err := client.ListSOSBucketsUsage(ctx)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error listing buckets: %w", err)
+		}
+		buckets = res.SOSBucketsUsage
+	}
+
+	if slices.Contains(kinds, manifestKindDNSDomain) {
+		docs, err := exportDNSDomains(ctx, client)
+		if err != nil {
+			return nil, 0, err
+		}
+		add(docs...)
+	}
+
+	sink := utils.NewWarningSink()
+	defer sink.Flush()
+
+	failed := utils.ForEveryZoneAsync(ctx, zones, globalstate.RequestTimeout, sink, true,
+		func(ctx context.Context, zone v3.Zone) error {
+			e := &zoneExporter{
+				client: client.WithEndpoint(zone.APIEndpoint),
+				zone:   string(zone.Name),
+				state:  newManifestState(client.WithEndpoint(zone.APIEndpoint)),
+			}
+			docs, err := e.export(ctx, kinds, buckets)
+			if err != nil {
+				return err
+			}
+			add(docs...)
+			return nil
+		})
+
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.Kind != b.Kind {
+			return slices.Index(exportKinds, a.Kind) < slices.Index(exportKinds, b.Kind)
+		}
+		if a.Zone != b.Zone {
+			return a.Zone < b.Zone
+		}
+		return exportDocumentName(a) < exportDocumentName(b)
+	})
+
+	return out, failed, nil
+}
+
+func exportDocumentName(doc exportDocument) string {
+	switch spec := doc.Spec.(type) {
+	case manifestSecurityGroup:
+		return spec.Name
+	case manifestPrivateNetwork:
+		return spec.Name
+	case manifestAntiAffinityGroup:
+		return spec.Name
+	case exportInstance:
+		return spec.Name
+	case manifestInstancePool:
+		return spec.Name
+	case manifestLoadBalancer:
+		return spec.Name
+	case exportSKSCluster:
+		return spec.Name
+	case exportSKSNodepool:
+		return spec.Cluster + "/" + spec.Name
+	case manifestDNSDomain:
+		return spec.Name
+	case *sos.ShowBucketOutput:
+		return spec.Name
+	}
+	return doc.ID
+}
+
+func exportDNSDomains(ctx context.Context, client *v3.Client) ([]exportDocument, error) {
+	domains, err := client.ListDNSDomains(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing %s: %w", manifestKindDNSDomain, err)
+	}
+
+	docs := make([]exportDocument, 0, len(domains.DNSDomains))
+	for _, domain := range domains.DNSDomains {
+		records, err := client.ListDNSDomainRecords(ctx, domain.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s %q records: %w", manifestKindDNSDomain, domain.UnicodeName, err)
+		}
+
+		spec := manifestDNSDomain{Name: domain.UnicodeName}
+		for _, r := range records.DNSDomainRecords {
+			if r.SystemRecord != nil && *r.SystemRecord {
+				continue
+			}
+			name := r.Name
+			if name == "" {
+				name = "@"
+			}
+			spec.Records = append(spec.Records, manifestDNSRecord{
+				Name:     name,
+				Type:     string(r.Type),
+				Content:  r.Content,
+				TTL:      r.Ttl,
+				Priority: r.Priority,
+			})
+		}
+		sort.SliceStable(spec.Records, func(i, j int) bool {
+			a, b := spec.Records[i], spec.Records[j]
+			return dnsRecordKey(a.Name, a.Type, a.Content, a.Priority) < dnsRecordKey(b.Name, b.Type, b.Content, b.Priority)
+		})
+
+		docs = append(docs, exportDocument{Kind: manifestKindDNSDomain, ID: domain.ID.String(), Spec: spec})
+	}
+
+	return docs, nil
+}
+
+// zoneExporter exports the resources of a zone, referencing the related
+// resources by name.
+type zoneExporter struct {
+	client *v3.Client
+	zone   string
+	state  *manifestState
+
+	elasticIPs    map[v3.UUID]string
+	instanceTypes map[v3.UUID]string
+}
+
+func (e *zoneExporter) export(ctx context.Context, kinds []string, buckets []v3.SOSBucketUsage) ([]exportDocument, error) {
+	if err := e.state.load(ctx,
+		manifestKindSecurityGroup,
+		manifestKindPrivateNetwork,
+		manifestKindAntiAffinityGroup,
+		manifestKindInstancePool,
+	); err != nil {
+		return nil, err
+	}
+
+	instanceTypes, err := e.client.ListInstanceTypes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing instance types: %w", err)
+	}
+	e.instanceTypes = make(map[v3.UUID]string, len(instanceTypes.InstanceTypes))
+	for _, it := range instanceTypes.InstanceTypes {
+		e.instanceTypes[it.ID] = fmt.Sprintf("%s.%s", it.Family, it.Size)
+	}
+
+	var docs []exportDocument
+	for _, kind := range kinds {
+		var (
+			d   []exportDocument
+			err error
+		)
+
+		switch kind {
+		case manifestKindSecurityGroup:
+			d = e.securityGroups()
+		case manifestKindPrivateNetwork:
+			d = e.privateNetworks()
+		case manifestKindAntiAffinityGroup:
+			d = e.antiAffinityGroups()
+		case manifestKindInstance:
+			d, err = e.instances(ctx)
+		case manifestKindInstancePool:
+			d = e.instancePools()
+		case manifestKindLoadBalancer:
+			d, err = e.loadBalancers(ctx)
+		case manifestKindSKSCluster, manifestKindSKSNodepool:
+			d, err = e.sksClusters(ctx, kind)
+		case manifestKindBucket:
+			d, err = e.buckets(ctx, buckets)
+		}
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, d...)
+	}
+
+	return docs, nil
+}
+
+func (e *zoneExporter) document(kind string, id v3.UUID, spec any) exportDocument {
+	return exportDocument{Kind: kind, Zone: e.zone, ID: id.String(), Spec: spec}
+}
+
+// securityGroupName returns the name of a Security Group, or its ID if it
+// cannot be resolved.
+func (e *zoneExporter) securityGroupName(id v3.UUID) string {
+	if sg, err := e.state.securityGroup(id.String()); err == nil && sg.Name != "" {
+		return sg.Name
+	}
+	return id.String()
+}
+
+func (e *zoneExporter) securityGroupNames(sgs []v3.SecurityGroup) []string {
+	names := make([]string, 0, len(sgs))
+	for _, sg := range sgs {
+		names = append(names, e.securityGroupName(sg.ID))
+	}
+	return names
+}
+
+func (e *zoneExporter) privateNetworkNames(ids []v3.UUID) []string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		name := id.String()
+		if pn, err := e.state.privateNetwork(id.String()); err == nil && pn.Name != "" {
+			name = pn.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func (e *zoneExporter) antiAffinityGroupNames(aags []v3.AntiAffinityGroup) []string {
+	names := make([]string, 0, len(aags))
+	for _, aag := range aags {
+		name := aag.ID.String()
+		if r, err := e.state.antiAffinityGroup(aag.ID.String()); err == nil && r.Name != "" {
+			name = r.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func (e *zoneExporter) instanceType(it *v3.InstanceType) string {
+	if it == nil {
+		return ""
+	}
+	if name, ok := e.instanceTypes[it.ID]; ok {
+		return name
+	}
+	return it.ID.String()
+}
+
+func exportTemplate(t *v3.Template) (string, string) {
+	switch {
+	case t == nil:
+		return "", ""
+	case t.Name == "":
+		return t.ID.String(), ""
+	case t.Visibility == v3.TemplateVisibilityPrivate:
+		return t.Name, string(t.Visibility)
+	default:
+		return t.Name, ""
+	}
+}
+
+func sshKeyNames(keys []v3.SSHKey) []string {
+	names := make([]string, 0, len(keys))
+	for _, k := range keys {
+		names = append(names, k.Name)
+	}
+	return names
+}
+
+func ipString(ip net.IP) string {
+	if ip == nil {
+		return ""
+	}
+	return ip.String()
+}
+
+func (e *zoneExporter) securityGroups() []exportDocument {
+	docs := make([]exportDocument, 0, len(e.state.securityGroups.SecurityGroups))
+	for _, sg := range e.state.securityGroups.SecurityGroups {
+		spec := manifestSecurityGroup{Name: sg.Name, Description: sg.Description}
+		for _, r := range sg.Rules {
+			spec.Rules = append(spec.Rules, e.securityGroupRule(r))
+		}
+		sort.SliceStable(spec.Rules, func(i, j int) bool {
+			return spec.Rules[i].String() < spec.Rules[j].String()
+		})
+
+		docs = append(docs, e.document(manifestKindSecurityGroup, sg.ID, spec))
+	}
+
+	return docs
+}
+
+func (e *zoneExporter) securityGroupRule(r v3.SecurityGroupRule) manifestSecurityGroupRule {
+	rule := manifestSecurityGroupRule{
+		Description: r.Description,
+		Flow:        string(r.FlowDirection),
+		Protocol:    string(r.Protocol),
+		Network:     r.Network,
+	}
+
+	switch {
+	case r.StartPort != 0 && r.StartPort == r.EndPort:
+		rule.Port = fmt.Sprint(r.StartPort)
+	case r.StartPort != 0:
+		rule.Port = fmt.Sprintf("%d-%d", r.StartPort, r.EndPort)
+	}
+
+	switch {
+	case r.SecurityGroup == nil:
+	case r.SecurityGroup.Visibility == v3.SecurityGroupResourceVisibilityPublic:
+		rule.PublicSecurityGroup = r.SecurityGroup.Name
+	case r.SecurityGroup.Name != "":
+		rule.SecurityGroup = r.SecurityGroup.Name
+	default:
+		rule.SecurityGroup = e.securityGroupName(r.SecurityGroup.ID)
+	}
+
+	if r.ICMP != nil {
+		icmpType, icmpCode := r.ICMP.Type, r.ICMP.Code
+		rule.ICMPType, rule.ICMPCode = &icmpType, &icmpCode
+	}
+
+	return rule
+}
+
+func (e *zoneExporter) privateNetworks() []exportDocument {
+	docs := make([]exportDocument, 0, len(e.state.privateNetworks.PrivateNetworks))
+	for _, pn := range e.state.privateNetworks.PrivateNetworks {
+		docs = append(docs, e.document(manifestKindPrivateNetwork, pn.ID, manifestPrivateNetwork{
+			Name:        pn.Name,
+			Description: pn.Description,
+			StartIP:     ipString(pn.StartIP),
+			EndIP:       ipString(pn.EndIP),
+			Netmask:     ipString(pn.Netmask),
+			Labels:      pn.Labels,
+		}))
+	}
+
+	return docs
+}
+
+func (e *zoneExporter) antiAffinityGroups() []exportDocument {
+	docs := make([]exportDocument, 0, len(e.state.antiAffinityGroups.AntiAffinityGroups))
+	for _, aag := range e.state.antiAffinityGroups.AntiAffinityGroups {
+		docs = append(docs, e.document(manifestKindAntiAffinityGroup, aag.ID, manifestAntiAffinityGroup{
+			Name:        aag.Name,
+			Description: aag.Description,
+		}))
+	}
+
+	return docs
+}
+
+func (e *zoneExporter) instances(ctx context.Context) ([]exportDocument, error) {
+	instances, err := e.client.ListInstances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing %s: %w", manifestKindInstance, err)
+	}
+
+	if e.elasticIPs == nil {
+		eips, err := e.client.ListElasticIPS(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error listing Elastic IPs: %w", err)
+		}
+		e.elasticIPs = make(map[v3.UUID]string, len(eips.ElasticIPS))
+		for _, eip := range eips.ElasticIPS {
+			e.elasticIPs[eip.ID] = eip.IP
+		}
+	}
+
+	docs := make([]exportDocument, 0, len(instances.Instances))
+	for _, i := range instances.Instances {
+		// Members of Instance Pools and SKS Nodepools are exported through
+		// their manager.
+		if i.Manager != nil {
+			continue
+		}
+
+		instance, err := e.client.GetInstance(ctx, i.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving %s %q: %w", manifestKindInstance, i.Name, err)
+		}
+
+		template, visibility := exportTemplate(instance.Template)
+		spec := exportInstance{manifestInstance: manifestInstance{
+			Name:               instance.Name,
+			InstanceType:       e.instanceType(instance.InstanceType),
+			Template:           template,
+			TemplateVisibility: visibility,
+			DiskSize:           instance.DiskSize,
+			SSHKeys:            sshKeyNames(instance.SSHKeys),
+			SecurityGroups:     e.securityGroupNames(instance.SecurityGroups),
+			AntiAffinityGroups: e.antiAffinityGroupNames(instance.AntiAffinityGroups),
+			Labels:             instance.Labels,
+		}}
+		pnIDs := make([]v3.UUID, 0, len(instance.PrivateNetworks))
+		for _, pn := range instance.PrivateNetworks {
+			pnIDs = append(pnIDs, pn.ID)
+		}
+		spec.PrivateNetworks = e.privateNetworkNames(pnIDs)
+		for _, eip := range instance.ElasticIPS {
+			ip, ok := e.elasticIPs[eip.ID]
+			if !ok {
+				ip = eip.ID.String()
+			}
+			spec.ElasticIPs = append(spec.ElasticIPs, ip)
+		}
+
+		docs = append(docs, e.document(manifestKindInstance, instance.ID, spec))
+	}
+
+	return docs, nil
+}
+
+func (e *zoneExporter) instancePools() []exportDocument {
+	docs := make([]exportDocument, 0, len(e.state.instancePools.InstancePools))
+	for _, pool := range e.state.instancePools.InstancePools {
+		// SKS Nodepools' Instance Pools are exported through the nodepools.
+		if pool.Manager != nil {
+			continue
+		}
+
+		template, visibility := exportTemplate(pool.Template)
+		docs = append(docs, e.document(manifestKindInstancePool, pool.ID, manifestInstancePool{
+			Name:               pool.Name,
+			Description:        pool.Description,
+			Size:               pool.Size,
+			InstanceType:       e.instanceType(pool.InstanceType),
+			InstancePrefix:     pool.InstancePrefix,
+			Template:           template,
+			TemplateVisibility: visibility,
+			DiskSize:           pool.DiskSize,
+			SSHKeys:            sshKeyNames(pool.SSHKeys),
+			SecurityGroups:     e.securityGroupNames(pool.SecurityGroups),
+			PrivateNetworks:    e.privateNetworkNames(privateNetworkIDs(pool.PrivateNetworks)),
+			AntiAffinityGroups: e.antiAffinityGroupNames(pool.AntiAffinityGroups),
+			Labels:             pool.Labels,
+		}))
+	}
+
+	return docs
+}
+
+func (e *zoneExporter) loadBalancers(ctx context.Context) ([]exportDocument, error) {
+	lbs, err := e.client.ListLoadBalancers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing %s: %w", manifestKindLoadBalancer, err)
+	}
+
+	docs := make([]exportDocument, 0, len(lbs.LoadBalancers))
+	for _, lb := range lbs.LoadBalancers {
+		spec := manifestLoadBalancer{Name: lb.Name, Description: lb.Description, Labels: lb.Labels}
+		for _, svc := range lb.Services {
+			s := manifestLoadBalancerService{
+				Name:        svc.Name,
+				Description: svc.Description,
+				Port:        svc.Port,
+				TargetPort:  svc.TargetPort,
+				Protocol:    string(svc.Protocol),
+				Strategy:    string(svc.Strategy),
+			}
+			if svc.InstancePool != nil {
+				s.InstancePool = svc.InstancePool.ID.String()
+				if pool, err := e.state.instancePool(svc.InstancePool.ID.String()); err == nil && pool.Name != "" {
+					s.InstancePool = pool.Name
+				}
+			}
+			if hc := svc.Healthcheck; hc != nil {
+				s.Healthcheck = manifestLoadBalancerHealthcheck{
+					Mode:     string(hc.Mode),
+					Port:     hc.Port,
+					URI:      hc.URI,
+					Interval: hc.Interval,
+					Timeout:  hc.Timeout,
+					Retries:  hc.Retries,
+					TLSSNI:   hc.TlsSNI,
+				}
+			}
+			spec.Services = append(spec.Services, s)
+		}
+		sort.SliceStable(spec.Services, func(i, j int) bool {
+			return spec.Services[i].Name < spec.Services[j].Name
+		})
+
+		docs = append(docs, e.document(manifestKindLoadBalancer, lb.ID, spec))
+	}
+
+	return docs, nil
+}
+
+func (e *zoneExporter) sksClusters(ctx context.Context, kind string) ([]exportDocument, error) {
+	clusters, err := e.client.ListSKSClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing %s: %w", kind, err)
+	}
+
+	var docs []exportDocument
+	for _, cluster := range clusters.SKSClusters {
+		if kind == manifestKindSKSCluster {
+			docs = append(docs, e.document(kind, cluster.ID, exportSKSCluster{
+				Name:        cluster.Name,
+				Description: cluster.Description,
+				Version:     cluster.Version,
+				Level:       string(cluster.Level),
+				CNI:         string(cluster.Cni),
+				AutoUpgrade: cluster.AutoUpgrade != nil && *cluster.AutoUpgrade,
+				Addons:      cluster.Addons,
+				Labels:      cluster.Labels,
+			}))
+			continue
+		}
+
+		for _, np := range cluster.Nodepools {
+			spec := exportSKSNodepool{
+				Cluster:            cluster.Name,
+				Name:               np.Name,
+				Description:        np.Description,
+				Size:               np.Size,
+				InstanceType:       e.instanceType(np.InstanceType),
+				InstancePrefix:     np.InstancePrefix,
+				DiskSize:           np.DiskSize,
+				SecurityGroups:     e.securityGroupNames(np.SecurityGroups),
+				PrivateNetworks:    e.privateNetworkNames(privateNetworkIDs(np.PrivateNetworks)),
+				AntiAffinityGroups: e.antiAffinityGroupNames(np.AntiAffinityGroups),
+				Labels:             np.Labels,
+				Addons:             np.Addons,
+			}
+			if len(np.Taints) > 0 {
+				spec.Taints = make(map[string]string, len(np.Taints))
+				for k, t := range np.Taints {
+					spec.Taints[k] = fmt.Sprintf("%s:%s", t.Value, t.Effect)
+				}
+			}
+
+			docs = append(docs, e.document(kind, np.ID, spec))
+		}
+	}
+
+	return docs, nil
+}
+
+func (e *zoneExporter) buckets(ctx context.Context, buckets []v3.SOSBucketUsage) ([]exportDocument, error) {
+	var docs []exportDocument
+	var storage *sos.Client
+	for _, b := range buckets {
+		if string(b.ZoneName) != e.zone {
+			continue
+		}
+
+		if storage == nil {
+			var err error
+			if storage, err = sos.NewStorageClient(ctx, sos.ClientOptWithZone(e.zone)); err != nil {
+				return nil, fmt.Errorf("unable to initialize storage client: %w", err)
+			}
+		}
+
+		out, err := storage.ShowBucket(ctx, b.Name)
+		if err != nil {
+			return nil, fmt.Errorf("bucket %q: %w", b.Name, err)
+		}
+
+		docs = append(docs, exportDocument{Kind: manifestKindBucket, Zone: e.zone, Spec: out})
+	}
+
+	return docs, nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(exocmd.RootCmd, &exportCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
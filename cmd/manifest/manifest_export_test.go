@@ -0,0 +1,75 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/testutils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+func TestExport(t *testing.T) {
+	server := newManifestTestServer(t, map[string]any{
+		"/instance": v3.ListInstancesResponse{Instances: []v3.ListInstancesResponseInstances{
+			{ID: "b1111111-1111-1111-1111-111111111111", Name: "bastion"},
+			{ID: "b2222222-2222-2222-2222-222222222222", Name: "pool-member", Manager: &v3.Manager{Type: "instance-pool"}},
+		}},
+		"/instance/b1111111-1111-1111-1111-111111111111": v3.Instance{
+			ID:             "b1111111-1111-1111-1111-111111111111",
+			Name:           "bastion",
+			InstanceType:   &v3.InstanceType{ID: "91111111-1111-1111-1111-111111111111"},
+			Template:       &v3.Template{ID: "a1111111-1111-1111-1111-111111111111", Name: "Linux Debian 12"},
+			DiskSize:       50,
+			SecurityGroups: []v3.SecurityGroup{{ID: "11111111-1111-1111-1111-111111111111"}},
+			ElasticIPS:     []v3.ElasticIP{{ID: "c1111111-1111-1111-1111-111111111111"}},
+		},
+		"/elastic-ip": v3.ListElasticIPSResponse{ElasticIPS: []v3.ElasticIP{
+			{ID: "c1111111-1111-1111-1111-111111111111", IP: "192.0.2.10"},
+		}},
+	})
+	defer server.Close()
+	testutils.SetupV3Client(t, server.URL)
+
+	zones := []v3.Zone{{APIEndpoint: v3.Endpoint(server.URL), Name: "ch-gva-2"}}
+	out, failed, err := export(exocmd.GContext, globalstate.EgoscaleV3Client, zones, []string{
+		manifestKindDNSDomain,
+		manifestKindInstance,
+		manifestKindSecurityGroup,
+	})
+	require.NoError(t, err)
+	require.Zero(t, failed)
+
+	require.Len(t, out, 3)
+	assert.Equal(t, manifestKindSecurityGroup, out[0].Kind)
+	assert.Equal(t, "ch-gva-2", out[0].Zone)
+	assert.Equal(t, manifestSecurityGroup{Name: "web", Rules: []manifestSecurityGroupRule{
+		{Flow: "ingress", Protocol: "tcp", Port: "80", Network: "0.0.0.0/0"},
+		{Flow: "ingress", Protocol: "tcp", Port: "8000-8080", SecurityGroup: "web"},
+	}}, out[0].Spec)
+
+	assert.Equal(t, manifestKindInstance, out[1].Kind)
+	assert.Equal(t, exportInstance{
+		manifestInstance: manifestInstance{
+			Name:               "bastion",
+			InstanceType:       "standard.small",
+			Template:           "Linux Debian 12",
+			DiskSize:           50,
+			SSHKeys:            []string{},
+			SecurityGroups:     []string{"web"},
+			PrivateNetworks:    []string{},
+			AntiAffinityGroups: []string{},
+		},
+		ElasticIPs: []string{"192.0.2.10"},
+	}, out[1].Spec)
+
+	assert.Equal(t, manifestKindDNSDomain, out[2].Kind)
+	assert.Empty(t, out[2].Zone)
+	assert.Equal(t, manifestDNSDomain{Name: "example.net", Records: []manifestDNSRecord{
+		{Name: "old", Type: "A", Content: "192.0.2.9"},
+		{Name: "www", Type: "A", Content: "192.0.2.1", TTL: 3600},
+	}}, out[2].Spec)
+}
@@ -0,0 +1,1670 @@
+package manifest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"sort"
+	"strings"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/account"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+const (
+	manifestKindSecurityGroup       = "security-group"
+	manifestKindSecurityGroupRule   = "security-group-rule"
+	manifestKindPrivateNetwork      = "private-network"
+	manifestKindAntiAffinityGroup   = "anti-affinity-group"
+	manifestKindInstance            = "instance"
+	manifestKindInstancePool        = "instance-pool"
+	manifestKindLoadBalancer        = "load-balancer"
+	manifestKindLoadBalancerService = "load-balancer-service"
+	manifestKindDNSDomain           = "dns-domain"
+	manifestKindDNSRecord           = "dns-record"
+
+	manifestActionCreate = "create"
+	manifestActionUpdate = "update"
+	manifestActionDelete = "delete"
+	// manifestActionDrift reports differences between the live state of a
+	// resource and the manifest which can't be reconciled in place, e.g.
+	// requiring the resource to be recreated: they are displayed in the plan
+	// but not applied.
+	manifestActionDrift = "drift"
+
+	manifestDefaultDiskSize = 50
+)
+
+// manifestKinds lists the kinds of top-level resources supported in
+// manifests, in dependency order.
+var manifestKinds = []string{
+	manifestKindSecurityGroup,
+	manifestKindPrivateNetwork,
+	manifestKindAntiAffinityGroup,
+	manifestKindInstance,
+	manifestKindInstancePool,
+	manifestKindLoadBalancer,
+	manifestKindDNSDomain,
+}
+
+var manifestSecurityGroupRuleProtocols = []string{
+	"ah",
+	"esp",
+	"gre",
+	"icmp",
+	"icmpv6",
+	"ipip",
+	"tcp",
+	"udp",
+}
+
+// The changes of a plan are applied by phase: sub-resources are removed
+// first, then resources are deleted in reverse dependency order, and finally
+// created or updated in dependency order.
+const (
+	manifestPhaseDeleteSubResource = iota
+	manifestPhaseDeleteLoadBalancer
+	manifestPhaseDeleteInstancePool
+	manifestPhaseDeleteInstance
+	manifestPhaseDeleteAntiAffinityGroup
+	manifestPhaseDeletePrivateNetwork
+	manifestPhaseDeleteSecurityGroup
+	manifestPhaseDeleteDNSDomain
+	manifestPhaseSecurityGroup
+	manifestPhaseSecurityGroupRule
+	manifestPhasePrivateNetwork
+	manifestPhaseAntiAffinityGroup
+	manifestPhaseInstance
+	manifestPhaseInstancePool
+	manifestPhaseLoadBalancer
+	manifestPhaseLoadBalancerService
+	manifestPhaseDNSDomain
+	manifestPhaseDNSRecord
+)
+
+// manifestChange represents a change to be performed on a resource to
+// reconcile its live state with a manifest.
+type manifestChange struct {
+	Action  string
+	Kind    string
+	Name    string
+	Details []string
+
+	phase int
+	// reload lists the kinds of resources to be reloaded once the change
+	// is applied, so that the next changes can reference them.
+	reload []string
+	apply  func(context.Context, *manifestState) error
+}
+
+type manifestPlanner struct {
+	m       *manifest
+	s       *manifestState
+	changes []*manifestChange
+}
+
+// planManifest compares a manifest with the live state of the resources,
+// and returns the changes to apply ordered by dependency.
+func planManifest(ctx context.Context, m *manifest, s *manifestState) ([]*manifestChange, error) {
+	if err := s.load(ctx, manifestKinds...); err != nil {
+		return nil, err
+	}
+
+	p := &manifestPlanner{m: m, s: s}
+	for _, plan := range []func(context.Context) error{
+		p.planSecurityGroups,
+		p.planPrivateNetworks,
+		p.planAntiAffinityGroups,
+		p.planInstances,
+		p.planInstancePools,
+		p.planLoadBalancers,
+		p.planDNSDomains,
+	} {
+		if err := plan(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(p.changes, func(i, j int) bool {
+		return p.changes[i].phase < p.changes[j].phase
+	})
+
+	return p.changes, nil
+}
+
+func (p *manifestPlanner) add(c *manifestChange) {
+	p.changes = append(p.changes, c)
+}
+
+// declared reports whether a resource is declared as present in the manifest.
+func (p *manifestPlanner) declared(kind, name string) bool {
+	var found bool
+	check := func(n, state string) {
+		if n == name && state != manifestStateAbsent {
+			found = true
+		}
+	}
+
+	switch kind {
+	case manifestKindSecurityGroup:
+		for _, r := range p.m.SecurityGroups {
+			check(r.Name, r.State)
+		}
+	case manifestKindPrivateNetwork:
+		for _, r := range p.m.PrivateNetworks {
+			check(r.Name, r.State)
+		}
+	case manifestKindAntiAffinityGroup:
+		for _, r := range p.m.AntiAffinityGroups {
+			check(r.Name, r.State)
+		}
+	case manifestKindInstancePool:
+		for _, r := range p.m.InstancePools {
+			check(r.Name, r.State)
+		}
+	}
+
+	return found
+}
+
+// checkReferences verifies that the resources referenced by a resource
+// either exist or are declared in the manifest, and returns their IDs
+// indexed by name for the existing ones.
+func (p *manifestPlanner) checkReferences(
+	owner, kind string,
+	names []string,
+	find func(string) (v3.UUID, error),
+) (map[string]v3.UUID, error) {
+	ids := make(map[string]v3.UUID, len(names))
+	for _, name := range names {
+		id, err := find(name)
+		switch {
+		case err == nil:
+			ids[name] = id
+		case errors.Is(err, v3.ErrNotFound) && p.declared(kind, name):
+		case errors.Is(err, v3.ErrNotFound):
+			return nil, fmt.Errorf("%s: %s %q not found", owner, kind, name)
+		default:
+			return nil, fmt.Errorf("%s: %w", owner, err)
+		}
+	}
+
+	return ids, nil
+}
+
+// diffReferences compares the names of the resources referenced in a
+// manifest with the IDs of the resources currently referenced, and returns
+// the names of the ones to add and the IDs of the ones to remove.
+func diffReferences(names []string, ids map[string]v3.UUID, current []v3.UUID) ([]string, []v3.UUID) {
+	var add []string
+	desired := make([]v3.UUID, 0, len(names))
+	for _, name := range names {
+		id, ok := ids[name]
+		if !ok || !slices.Contains(current, id) {
+			add = append(add, name)
+		}
+		if ok {
+			desired = append(desired, id)
+		}
+	}
+
+	var remove []v3.UUID
+	for _, id := range current {
+		if !slices.Contains(desired, id) {
+			remove = append(remove, id)
+		}
+	}
+
+	return add, remove
+}
+
+// exists reports whether a resource lookup succeeded, and returns the
+// lookup error if it is not a "not found" error.
+func exists(err error) (bool, error) {
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, v3.ErrNotFound):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func labelsChanged(desired map[string]string, current v3.Labels) bool {
+	return desired != nil && !maps.Equal(desired, map[string]string(current))
+}
+
+func (p *manifestPlanner) planSecurityGroups(_ context.Context) error {
+	for _, sg := range p.m.SecurityGroups {
+		live, err := p.s.securityGroup(sg.Name)
+		found, err := exists(err)
+		if err != nil {
+			return err
+		}
+
+		if sg.State == manifestStateAbsent {
+			if found {
+				id := live.ID
+				p.add(&manifestChange{
+					Action: manifestActionDelete,
+					Kind:   manifestKindSecurityGroup,
+					Name:   sg.Name,
+					phase:  manifestPhaseDeleteSecurityGroup,
+					apply: func(ctx context.Context, s *manifestState) error {
+						_, err := s.wait(ctx)(s.client.DeleteSecurityGroup(ctx, id))
+						return err
+					},
+				})
+			}
+			continue
+		}
+
+		for _, rule := range sg.Rules {
+			if rule.SecurityGroup != "" {
+				if _, err := p.checkReferences(
+					fmt.Sprintf("%s %q", manifestKindSecurityGroup, sg.Name),
+					manifestKindSecurityGroup,
+					[]string{rule.SecurityGroup},
+					func(name string) (v3.UUID, error) { sg, err := p.s.securityGroup(name); return sg.ID, err },
+				); err != nil {
+					return err
+				}
+			}
+		}
+
+		if !found {
+			p.add(&manifestChange{
+				Action: manifestActionCreate,
+				Kind:   manifestKindSecurityGroup,
+				Name:   sg.Name,
+				phase:  manifestPhaseSecurityGroup,
+				reload: []string{manifestKindSecurityGroup},
+				apply: func(ctx context.Context, s *manifestState) error {
+					_, err := s.wait(ctx)(s.client.CreateSecurityGroup(ctx, v3.CreateSecurityGroupRequest{
+						Name:        sg.Name,
+						Description: sg.Description,
+					}))
+					return err
+				},
+			})
+		}
+
+		if found && sg.Description != "" && sg.Description != live.Description {
+			p.add(&manifestChange{
+				Action:  manifestActionDrift,
+				Kind:    manifestKindSecurityGroup,
+				Name:    sg.Name,
+				Details: []string{"description (requires recreating the Security Group)"},
+				phase:   manifestPhaseSecurityGroup,
+			})
+		}
+
+		// Rules are only managed if listed in the manifest, an empty list
+		// meaning that all the rules are to be deleted.
+		if sg.Rules == nil {
+			continue
+		}
+
+		current := make(map[string]v3.SecurityGroupRule, len(live.Rules))
+		for _, rule := range live.Rules {
+			current[securityGroupRuleKey(rule)] = rule
+		}
+
+		desired := make(map[string]struct{}, len(sg.Rules))
+		for _, rule := range sg.Rules {
+			key := rule.key(p.s)
+			desired[key] = struct{}{}
+			if _, ok := current[key]; ok {
+				continue
+			}
+
+			p.add(&manifestChange{
+				Action:  manifestActionCreate,
+				Kind:    manifestKindSecurityGroupRule,
+				Name:    sg.Name,
+				Details: []string{rule.String()},
+				phase:   manifestPhaseSecurityGroupRule,
+				apply: func(ctx context.Context, s *manifestState) error {
+					group, err := s.securityGroup(sg.Name)
+					if err != nil {
+						return err
+					}
+					req, err := rule.request(s)
+					if err != nil {
+						return err
+					}
+					_, err = s.wait(ctx)(s.client.AddRuleToSecurityGroup(ctx, group.ID, req))
+					return err
+				},
+			})
+		}
+
+		for _, rule := range live.Rules {
+			if _, ok := desired[securityGroupRuleKey(rule)]; ok {
+				continue
+			}
+
+			id, ruleID := live.ID, rule.ID
+			p.add(&manifestChange{
+				Action:  manifestActionDelete,
+				Kind:    manifestKindSecurityGroupRule,
+				Name:    sg.Name,
+				Details: []string{securityGroupRuleString(rule)},
+				phase:   manifestPhaseDeleteSubResource,
+				apply: func(ctx context.Context, s *manifestState) error {
+					_, err := s.wait(ctx)(s.client.DeleteRuleFromSecurityGroup(ctx, id, ruleID))
+					return err
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+// key returns the identity of a rule, matching the one of the equivalent
+// live rule returned by securityGroupRuleKey().
+func (r *manifestSecurityGroupRule) key(s *manifestState) string {
+	start, end, _ := r.ports()
+
+	target := r.Network
+	switch {
+	case r.SecurityGroup != "":
+		target = "name:" + r.SecurityGroup
+		if sg, err := s.securityGroup(r.SecurityGroup); err == nil {
+			target = "id:" + sg.ID.String()
+		}
+	case r.PublicSecurityGroup != "":
+		target = "public:" + r.PublicSecurityGroup
+	}
+
+	var icmp string
+	if r.ICMPType != nil || r.ICMPCode != nil {
+		icmp = fmt.Sprintf("%d/%d", derefInt64(r.ICMPType), derefInt64(r.ICMPCode))
+	}
+
+	return strings.Join([]string{r.Flow, r.Protocol, fmt.Sprintf("%d-%d", start, end), target, icmp}, "|")
+}
+
+func securityGroupRuleKey(r v3.SecurityGroupRule) string {
+	target := r.Network
+	if r.Network != "" {
+		if _, network, err := net.ParseCIDR(r.Network); err == nil {
+			target = network.String()
+		}
+	}
+	if r.SecurityGroup != nil {
+		if r.SecurityGroup.Visibility == v3.SecurityGroupResourceVisibilityPublic {
+			target = "public:" + r.SecurityGroup.Name
+		} else {
+			target = "id:" + r.SecurityGroup.ID.String()
+		}
+	}
+
+	var icmp string
+	if r.ICMP != nil {
+		icmp = fmt.Sprintf("%d/%d", r.ICMP.Type, r.ICMP.Code)
+	}
+
+	return strings.Join([]string{
+		string(r.FlowDirection),
+		string(r.Protocol),
+		fmt.Sprintf("%d-%d", r.StartPort, r.EndPort),
+		target,
+		icmp,
+	}, "|")
+}
+
+func (r manifestSecurityGroupRule) String() string {
+	parts := []string{r.Flow, r.Protocol}
+	if r.Port != "" {
+		parts = append(parts, "port "+r.Port)
+	}
+	switch {
+	case r.SecurityGroup != "":
+		parts = append(parts, "security-group "+r.SecurityGroup)
+	case r.PublicSecurityGroup != "":
+		parts = append(parts, "public-security-group "+r.PublicSecurityGroup)
+	default:
+		parts = append(parts, "network "+r.Network)
+	}
+	if r.ICMPType != nil || r.ICMPCode != nil {
+		parts = append(parts, fmt.Sprintf("icmp %d/%d", derefInt64(r.ICMPType), derefInt64(r.ICMPCode)))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func securityGroupRuleString(r v3.SecurityGroupRule) string {
+	parts := []string{string(r.FlowDirection), string(r.Protocol)}
+	switch {
+	case r.StartPort != 0 && r.StartPort == r.EndPort:
+		parts = append(parts, fmt.Sprintf("port %d", r.StartPort))
+	case r.StartPort != 0:
+		parts = append(parts, fmt.Sprintf("port %d-%d", r.StartPort, r.EndPort))
+	}
+	switch {
+	case r.SecurityGroup != nil && r.SecurityGroup.Visibility == v3.SecurityGroupResourceVisibilityPublic:
+		parts = append(parts, "public-security-group "+r.SecurityGroup.Name)
+	case r.SecurityGroup != nil && r.SecurityGroup.Name != "":
+		parts = append(parts, "security-group "+r.SecurityGroup.Name)
+	case r.SecurityGroup != nil:
+		parts = append(parts, "security-group "+r.SecurityGroup.ID.String())
+	default:
+		parts = append(parts, "network "+r.Network)
+	}
+	if r.ICMP != nil {
+		parts = append(parts, fmt.Sprintf("icmp %d/%d", r.ICMP.Type, r.ICMP.Code))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// request returns the API request adding a rule to a Security Group.
+func (r *manifestSecurityGroupRule) request(s *manifestState) (v3.AddRuleToSecurityGroupRequest, error) {
+	req := v3.AddRuleToSecurityGroupRequest{
+		Description:   r.Description,
+		FlowDirection: v3.AddRuleToSecurityGroupRequestFlowDirection(r.Flow),
+		Protocol:      v3.AddRuleToSecurityGroupRequestProtocol(r.Protocol),
+		Network:       r.Network,
+	}
+
+	switch {
+	case r.SecurityGroup != "":
+		sg, err := s.securityGroup(r.SecurityGroup)
+		if err != nil {
+			return req, fmt.Errorf("unable to retrieve Security Group %q: %w", r.SecurityGroup, err)
+		}
+		req.SecurityGroup = &v3.SecurityGroupResource{ID: sg.ID}
+	case r.PublicSecurityGroup != "":
+		req.SecurityGroup = &v3.SecurityGroupResource{
+			Visibility: v3.SecurityGroupResourceVisibilityPublic,
+			Name:       r.PublicSecurityGroup,
+		}
+	}
+
+	start, end, err := r.ports()
+	if err != nil {
+		return req, err
+	}
+	req.StartPort, req.EndPort = start, end
+
+	if r.ICMPType != nil || r.ICMPCode != nil {
+		icmpType, icmpCode := derefInt64(r.ICMPType), derefInt64(r.ICMPCode)
+		req.ICMP = &v3.AddRuleToSecurityGroupRequestICMP{Type: &icmpType, Code: &icmpCode}
+	}
+
+	return req, nil
+}
+
+func derefInt64(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func (p *manifestPlanner) planPrivateNetworks(_ context.Context) error {
+	for _, pn := range p.m.PrivateNetworks {
+		live, err := p.s.privateNetwork(pn.Name)
+		found, err := exists(err)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case pn.State == manifestStateAbsent && found:
+			id := live.ID
+			p.add(&manifestChange{
+				Action: manifestActionDelete,
+				Kind:   manifestKindPrivateNetwork,
+				Name:   pn.Name,
+				phase:  manifestPhaseDeletePrivateNetwork,
+				apply: func(ctx context.Context, s *manifestState) error {
+					_, err := s.wait(ctx)(s.client.DeletePrivateNetwork(ctx, id))
+					return err
+				},
+			})
+
+		case pn.State == manifestStateAbsent:
+
+		case !found:
+			p.add(&manifestChange{
+				Action: manifestActionCreate,
+				Kind:   manifestKindPrivateNetwork,
+				Name:   pn.Name,
+				phase:  manifestPhasePrivateNetwork,
+				reload: []string{manifestKindPrivateNetwork},
+				apply: func(ctx context.Context, s *manifestState) error {
+					_, err := s.wait(ctx)(s.client.CreatePrivateNetwork(ctx, v3.CreatePrivateNetworkRequest{
+						Name:        pn.Name,
+						Description: pn.Description,
+						StartIP:     net.ParseIP(pn.StartIP),
+						EndIP:       net.ParseIP(pn.EndIP),
+						Netmask:     net.ParseIP(pn.Netmask),
+						Labels:      pn.Labels,
+					}))
+					return err
+				},
+			})
+
+		default:
+			var details []string
+			req := v3.UpdatePrivateNetworkRequest{}
+			if pn.Description != "" && pn.Description != live.Description {
+				req.Description = pn.Description
+				details = append(details, "description")
+			}
+			for _, f := range []struct {
+				name    string
+				desired string
+				current net.IP
+				set     *net.IP
+			}{
+				{"start-ip", pn.StartIP, live.StartIP, &req.StartIP},
+				{"end-ip", pn.EndIP, live.EndIP, &req.EndIP},
+				{"netmask", pn.Netmask, live.Netmask, &req.Netmask},
+			} {
+				if ip := net.ParseIP(f.desired); ip != nil && !ip.Equal(f.current) {
+					*f.set = ip
+					details = append(details, f.name)
+				}
+			}
+			if labelsChanged(pn.Labels, live.Labels) {
+				req.Labels = pn.Labels
+				details = append(details, "labels")
+			}
+
+			if len(details) > 0 {
+				id := live.ID
+				p.add(&manifestChange{
+					Action:  manifestActionUpdate,
+					Kind:    manifestKindPrivateNetwork,
+					Name:    pn.Name,
+					Details: details,
+					phase:   manifestPhasePrivateNetwork,
+					apply: func(ctx context.Context, s *manifestState) error {
+						_, err := s.wait(ctx)(s.client.UpdatePrivateNetwork(ctx, id, req))
+						return err
+					},
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *manifestPlanner) planAntiAffinityGroups(_ context.Context) error {
+	for _, aag := range p.m.AntiAffinityGroups {
+		live, err := p.s.antiAffinityGroup(aag.Name)
+		found, err := exists(err)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case aag.State == manifestStateAbsent && found:
+			id := live.ID
+			p.add(&manifestChange{
+				Action: manifestActionDelete,
+				Kind:   manifestKindAntiAffinityGroup,
+				Name:   aag.Name,
+				phase:  manifestPhaseDeleteAntiAffinityGroup,
+				apply: func(ctx context.Context, s *manifestState) error {
+					_, err := s.wait(ctx)(s.client.DeleteAntiAffinityGroup(ctx, id))
+					return err
+				},
+			})
+
+		case aag.State != manifestStateAbsent && !found:
+			p.add(&manifestChange{
+				Action: manifestActionCreate,
+				Kind:   manifestKindAntiAffinityGroup,
+				Name:   aag.Name,
+				phase:  manifestPhaseAntiAffinityGroup,
+				reload: []string{manifestKindAntiAffinityGroup},
+				apply: func(ctx context.Context, s *manifestState) error {
+					_, err := s.wait(ctx)(s.client.CreateAntiAffinityGroup(ctx, v3.CreateAntiAffinityGroupRequest{
+						Name:        aag.Name,
+						Description: aag.Description,
+					}))
+					return err
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+// computeReferences checks the references of a Compute instance or Instance
+// Pool and returns the IDs of the existing Security Groups and Private
+// Networks referenced.
+func (p *manifestPlanner) computeReferences(
+	owner string,
+	securityGroups, privateNetworks, antiAffinityGroups []string,
+) (map[string]v3.UUID, map[string]v3.UUID, error) {
+	sgIDs, err := p.checkReferences(owner, manifestKindSecurityGroup, securityGroups,
+		func(name string) (v3.UUID, error) { r, err := p.s.securityGroup(name); return r.ID, err })
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pnIDs, err := p.checkReferences(owner, manifestKindPrivateNetwork, privateNetworks,
+		func(name string) (v3.UUID, error) { r, err := p.s.privateNetwork(name); return r.ID, err })
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := p.checkReferences(owner, manifestKindAntiAffinityGroup, antiAffinityGroups,
+		func(name string) (v3.UUID, error) { r, err := p.s.antiAffinityGroup(name); return r.ID, err }); err != nil {
+		return nil, nil, err
+	}
+
+	return sgIDs, pnIDs, nil
+}
+
+func manifestSSHKeys(names []string) []v3.SSHKey {
+	if len(names) == 0 && account.CurrentAccount.DefaultSSHKey != "" {
+		names = []string{account.CurrentAccount.DefaultSSHKey}
+	}
+
+	keys := make([]v3.SSHKey, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, v3.SSHKey{Name: name})
+	}
+
+	return keys
+}
+
+func manifestTemplate(template string) string {
+	if template != "" {
+		return template
+	}
+	if account.CurrentAccount.DefaultTemplate != "" {
+		return account.CurrentAccount.DefaultTemplate
+	}
+	return exocmd.DefaultTemplate
+}
+
+func manifestInstanceType(instanceType string) string {
+	if instanceType != "" {
+		return instanceType
+	}
+	return fmt.Sprintf("%s.%s", exocmd.DefaultInstanceTypeFamily, exocmd.DefaultInstanceType)
+}
+
+func manifestDiskSize(diskSize int64) int64 {
+	if diskSize != 0 {
+		return diskSize
+	}
+	return manifestDefaultDiskSize
+}
+
+func (p *manifestPlanner) planInstances(ctx context.Context) error { //nolint:gocyclo
+	for _, instance := range p.m.Instances {
+		live, err := p.s.instance(instance.Name)
+		found, err := exists(err)
+		if err != nil {
+			return err
+		}
+
+		if instance.State == manifestStateAbsent {
+			if found {
+				id := live.ID
+				p.add(&manifestChange{
+					Action: manifestActionDelete,
+					Kind:   manifestKindInstance,
+					Name:   instance.Name,
+					phase:  manifestPhaseDeleteInstance,
+					apply: func(ctx context.Context, s *manifestState) error {
+						_, err := s.wait(ctx)(s.client.DeleteInstance(ctx, id))
+						return err
+					},
+				})
+			}
+			continue
+		}
+
+		owner := fmt.Sprintf("%s %q", manifestKindInstance, instance.Name)
+		sgIDs, pnIDs, err := p.computeReferences(owner, instance.SecurityGroups, instance.PrivateNetworks, instance.AntiAffinityGroups)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			instanceType, err := p.s.instanceType(ctx, manifestInstanceType(instance.InstanceType))
+			if err != nil {
+				return fmt.Errorf("%s: %w", owner, err)
+			}
+			template, err := p.s.template(ctx, manifestTemplate(instance.Template), instance.TemplateVisibility)
+			if err != nil {
+				return fmt.Errorf("%s: %w", owner, err)
+			}
+
+			p.add(&manifestChange{
+				Action: manifestActionCreate,
+				Kind:   manifestKindInstance,
+				Name:   instance.Name,
+				Details: []string{
+					"type " + manifestInstanceType(instance.InstanceType),
+					"template " + template.Name,
+				},
+				phase:  manifestPhaseInstance,
+				reload: []string{manifestKindInstance},
+				apply: func(ctx context.Context, s *manifestState) error {
+					return createManifestInstance(ctx, s, instance, instanceType, template)
+				},
+			})
+			continue
+		}
+
+		var details []string
+		if labelsChanged(instance.Labels, live.Labels) {
+			details = append(details, "labels")
+		}
+
+		var scaleType *v3.InstanceType
+		if instance.InstanceType != "" {
+			instanceType, err := p.s.instanceType(ctx, instance.InstanceType)
+			if err != nil {
+				return fmt.Errorf("%s: %w", owner, err)
+			}
+			if live.InstanceType == nil || live.InstanceType.ID != instanceType.ID {
+				scaleType = &v3.InstanceType{ID: instanceType.ID}
+				details = append(details, "instance-type "+instance.InstanceType)
+			}
+		}
+
+		drift, resizeDisk, err := p.instanceDrift(ctx, instance, live)
+		if err != nil {
+			return fmt.Errorf("%s: %w", owner, err)
+		}
+		if resizeDisk != 0 {
+			details = append(details, fmt.Sprintf("disk-size %d", resizeDisk))
+		}
+		if len(drift) > 0 {
+			p.add(&manifestChange{
+				Action:  manifestActionDrift,
+				Kind:    manifestKindInstance,
+				Name:    instance.Name,
+				Details: drift,
+				phase:   manifestPhaseInstance,
+			})
+		}
+
+		var addSGs, addPNs []string
+		var removeSGs, removePNs []v3.UUID
+		if instance.SecurityGroups != nil {
+			current := make([]v3.UUID, 0, len(live.SecurityGroups))
+			for _, sg := range live.SecurityGroups {
+				current = append(current, sg.ID)
+			}
+			addSGs, removeSGs = diffReferences(instance.SecurityGroups, sgIDs, current)
+			if len(addSGs)+len(removeSGs) > 0 {
+				details = append(details, "security-groups")
+			}
+		}
+		if instance.PrivateNetworks != nil {
+			current := make([]v3.UUID, 0, len(live.PrivateNetworks))
+			for _, pn := range live.PrivateNetworks {
+				current = append(current, pn.ID)
+			}
+			addPNs, removePNs = diffReferences(instance.PrivateNetworks, pnIDs, current)
+			if len(addPNs)+len(removePNs) > 0 {
+				details = append(details, "private-networks")
+			}
+		}
+
+		if len(details) == 0 {
+			continue
+		}
+
+		id := live.ID
+		p.add(&manifestChange{
+			Action:  manifestActionUpdate,
+			Kind:    manifestKindInstance,
+			Name:    instance.Name,
+			Details: details,
+			phase:   manifestPhaseInstance,
+			apply: func(ctx context.Context, s *manifestState) error {
+				if labelsChanged(instance.Labels, live.Labels) {
+					if _, err := s.wait(ctx)(s.client.UpdateInstance(ctx, id, v3.UpdateInstanceRequest{
+						Labels: instance.Labels,
+					})); err != nil {
+						return err
+					}
+				}
+
+				if scaleType != nil {
+					if _, err := s.wait(ctx)(s.client.ScaleInstance(ctx, id, v3.ScaleInstanceRequest{
+						InstanceType: scaleType,
+					})); err != nil {
+						return err
+					}
+				}
+				if resizeDisk != 0 {
+					if _, err := s.wait(ctx)(s.client.ResizeInstanceDisk(ctx, id, v3.ResizeInstanceDiskRequest{
+						DiskSize: resizeDisk,
+					})); err != nil {
+						return err
+					}
+				}
+
+				for _, sgID := range removeSGs {
+					if _, err := s.wait(ctx)(s.client.DetachInstanceFromSecurityGroup(ctx, sgID, v3.DetachInstanceFromSecurityGroupRequest{
+						Instance: &v3.Instance{ID: id},
+					})); err != nil {
+						return err
+					}
+				}
+				for _, name := range addSGs {
+					sg, err := s.securityGroup(name)
+					if err != nil {
+						return err
+					}
+					if _, err := s.wait(ctx)(s.client.AttachInstanceToSecurityGroup(ctx, sg.ID, v3.AttachInstanceToSecurityGroupRequest{
+						Instance: &v3.Instance{ID: id},
+					})); err != nil {
+						return err
+					}
+				}
+
+				for _, pnID := range removePNs {
+					if _, err := s.wait(ctx)(s.client.DetachInstanceFromPrivateNetwork(ctx, pnID, v3.DetachInstanceFromPrivateNetworkRequest{
+						Instance: &v3.Instance{ID: id},
+					})); err != nil {
+						return err
+					}
+				}
+				for _, name := range addPNs {
+					pn, err := s.privateNetwork(name)
+					if err != nil {
+						return err
+					}
+					if _, err := s.wait(ctx)(s.client.AttachInstanceToPrivateNetwork(ctx, pn.ID, v3.AttachInstanceToPrivateNetworkRequest{
+						Instance: &v3.AttachInstanceToPrivateNetworkRequestInstance{ID: id},
+					})); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+		})
+	}
+
+	return nil
+}
+
+// instanceDrift compares the settings of an existing instance which can't
+// be changed in place with the manifest, and returns the ones differing
+// along with the disk size to grow the instance disk to, if any.
+func (p *manifestPlanner) instanceDrift(
+	ctx context.Context,
+	instance manifestInstance,
+	live v3.ListInstancesResponseInstances,
+) ([]string, int64, error) {
+	var (
+		drift      []string
+		resizeDisk int64
+	)
+
+	if instance.Template != "" {
+		template, err := p.s.template(ctx, instance.Template, instance.TemplateVisibility)
+		if err != nil {
+			return nil, 0, err
+		}
+		if live.Template == nil || live.Template.ID != template.ID {
+			drift = append(drift, "template (requires recreating the instance)")
+		}
+	}
+
+	if instance.SSHKeys != nil {
+		current := make([]string, 0, len(live.SSHKeys))
+		for _, key := range live.SSHKeys {
+			current = append(current, key.Name)
+		}
+		if !slices.Equal(slices.Sorted(slices.Values(instance.SSHKeys)), slices.Sorted(slices.Values(current))) {
+			drift = append(drift, "ssh-keys (requires recreating the instance)")
+		}
+	}
+
+	// The disk size and Anti-Affinity Groups are not returned when listing
+	// instances.
+	if instance.DiskSize == 0 && instance.AntiAffinityGroups == nil {
+		return drift, 0, nil
+	}
+	full, err := p.s.client.GetInstance(ctx, live.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch {
+	case instance.DiskSize > full.DiskSize:
+		resizeDisk = instance.DiskSize
+	case instance.DiskSize != 0 && instance.DiskSize < full.DiskSize:
+		drift = append(drift, fmt.Sprintf("disk-size (cannot be shrunk from %d to %d GB)", full.DiskSize, instance.DiskSize))
+	}
+
+	if instance.AntiAffinityGroups != nil {
+		if antiAffinityGroupsChanged(p.s, instance.AntiAffinityGroups, full.AntiAffinityGroups) != nil {
+			drift = append(drift, "anti-affinity-groups (requires recreating the instance)")
+		}
+	}
+
+	return drift, resizeDisk, nil
+}
+
+func createManifestInstance(
+	ctx context.Context,
+	s *manifestState,
+	instance manifestInstance,
+	instanceType v3.InstanceType,
+	template v3.Template,
+) error {
+	req := v3.CreateInstanceRequest{
+		Name:               instance.Name,
+		InstanceType:       &v3.InstanceType{ID: instanceType.ID},
+		Template:           &v3.Template{ID: template.ID},
+		DiskSize:           manifestDiskSize(instance.DiskSize),
+		PublicIPAssignment: v3.PublicIPAssignmentInet4,
+		Labels:             instance.Labels,
+	}
+
+	if keys := manifestSSHKeys(instance.SSHKeys); len(keys) > 0 {
+		req.SSHKeys = keys
+	}
+
+	var err error
+	if req.SecurityGroups, err = s.securityGroupIDs(instance.SecurityGroups); err != nil {
+		return err
+	}
+	if req.AntiAffinityGroups, err = s.antiAffinityGroupIDs(instance.AntiAffinityGroups); err != nil {
+		return err
+	}
+	privateNetworks, err := s.privateNetworkIDs(instance.PrivateNetworks)
+	if err != nil {
+		return err
+	}
+
+	op, err := s.wait(ctx)(s.client.CreateInstance(ctx, req))
+	if err != nil {
+		return err
+	}
+	if op.Reference == nil {
+		return fmt.Errorf("unable to retrieve instance %q ID", instance.Name)
+	}
+
+	for _, pn := range privateNetworks {
+		if _, err := s.wait(ctx)(s.client.AttachInstanceToPrivateNetwork(ctx, pn.ID, v3.AttachInstanceToPrivateNetworkRequest{
+			Instance: &v3.AttachInstanceToPrivateNetworkRequestInstance{ID: op.Reference.ID},
+		})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *manifestPlanner) planInstancePools(ctx context.Context) error { //nolint:gocyclo
+	for _, pool := range p.m.InstancePools {
+		live, err := p.s.instancePool(pool.Name)
+		found, err := exists(err)
+		if err != nil {
+			return err
+		}
+
+		if pool.State == manifestStateAbsent {
+			if found {
+				id := live.ID
+				p.add(&manifestChange{
+					Action: manifestActionDelete,
+					Kind:   manifestKindInstancePool,
+					Name:   pool.Name,
+					phase:  manifestPhaseDeleteInstancePool,
+					apply: func(ctx context.Context, s *manifestState) error {
+						_, err := s.wait(ctx)(s.client.DeleteInstancePool(ctx, id))
+						return err
+					},
+				})
+			}
+			continue
+		}
+
+		owner := fmt.Sprintf("%s %q", manifestKindInstancePool, pool.Name)
+		sgIDs, pnIDs, err := p.computeReferences(owner, pool.SecurityGroups, pool.PrivateNetworks, pool.AntiAffinityGroups)
+		if err != nil {
+			return err
+		}
+
+		var instanceType v3.InstanceType
+		if pool.InstanceType != "" || !found {
+			if instanceType, err = p.s.instanceType(ctx, manifestInstanceType(pool.InstanceType)); err != nil {
+				return fmt.Errorf("%s: %w", owner, err)
+			}
+		}
+		var template v3.Template
+		if pool.Template != "" || !found {
+			if template, err = p.s.template(ctx, manifestTemplate(pool.Template), pool.TemplateVisibility); err != nil {
+				return fmt.Errorf("%s: %w", owner, err)
+			}
+		}
+
+		if !found {
+			p.add(&manifestChange{
+				Action: manifestActionCreate,
+				Kind:   manifestKindInstancePool,
+				Name:   pool.Name,
+				Details: []string{
+					fmt.Sprintf("size %d", pool.Size),
+					"type " + manifestInstanceType(pool.InstanceType),
+					"template " + template.Name,
+				},
+				phase:  manifestPhaseInstancePool,
+				reload: []string{manifestKindInstancePool},
+				apply: func(ctx context.Context, s *manifestState) error {
+					req := v3.CreateInstancePoolRequest{
+						Name:           pool.Name,
+						Description:    pool.Description,
+						Size:           pool.Size,
+						InstanceType:   &v3.InstanceType{ID: instanceType.ID},
+						InstancePrefix: pool.InstancePrefix,
+						Template:       &v3.Template{ID: template.ID},
+						DiskSize:       manifestDiskSize(pool.DiskSize),
+						Labels:         pool.Labels,
+					}
+					if keys := manifestSSHKeys(pool.SSHKeys); len(keys) > 0 {
+						req.SSHKeys = keys
+					}
+
+					var err error
+					if req.SecurityGroups, err = s.securityGroupIDs(pool.SecurityGroups); err != nil {
+						return err
+					}
+					if req.PrivateNetworks, err = s.privateNetworkIDs(pool.PrivateNetworks); err != nil {
+						return err
+					}
+					if req.AntiAffinityGroups, err = s.antiAffinityGroupIDs(pool.AntiAffinityGroups); err != nil {
+						return err
+					}
+
+					_, err = s.wait(ctx)(s.client.CreateInstancePool(ctx, req))
+					return err
+				},
+			})
+			continue
+		}
+
+		var details []string
+		if pool.Description != "" && pool.Description != live.Description {
+			details = append(details, "description")
+		}
+		if labelsChanged(pool.Labels, live.Labels) {
+			details = append(details, "labels")
+		}
+		if pool.InstanceType != "" && (live.InstanceType == nil || live.InstanceType.ID != instanceType.ID) {
+			details = append(details, "instance-type")
+		}
+		if pool.InstancePrefix != "" && pool.InstancePrefix != live.InstancePrefix {
+			details = append(details, "instance-prefix")
+		}
+		if pool.Template != "" && (live.Template == nil || live.Template.ID != template.ID) {
+			details = append(details, "template")
+		}
+		if pool.DiskSize != 0 && pool.DiskSize != live.DiskSize {
+			details = append(details, "disk-size")
+		}
+		for _, f := range []struct {
+			name    string
+			names   []string
+			ids     map[string]v3.UUID
+			current []v3.UUID
+		}{
+			{"security-groups", pool.SecurityGroups, sgIDs, securityGroupIDs(live.SecurityGroups)},
+			{"private-networks", pool.PrivateNetworks, pnIDs, privateNetworkIDs(live.PrivateNetworks)},
+		} {
+			if f.names == nil {
+				continue
+			}
+			if add, remove := diffReferences(f.names, f.ids, f.current); len(add)+len(remove) > 0 {
+				details = append(details, f.name)
+			}
+		}
+		if pool.AntiAffinityGroups != nil {
+			details = append(details, antiAffinityGroupsChanged(p.s, pool.AntiAffinityGroups, live.AntiAffinityGroups)...)
+		}
+		update := len(details) > 0
+
+		scale := pool.Size != live.Size
+		if scale {
+			details = append(details, fmt.Sprintf("size %d -> %d", live.Size, pool.Size))
+		}
+
+		if len(details) == 0 {
+			continue
+		}
+
+		p.add(&manifestChange{
+			Action:  manifestActionUpdate,
+			Kind:    manifestKindInstancePool,
+			Name:    pool.Name,
+			Details: details,
+			phase:   manifestPhaseInstancePool,
+			apply: func(ctx context.Context, s *manifestState) error {
+				if update {
+					req, err := manifestInstancePoolUpdateRequest(s, pool, live, instanceType, template)
+					if err != nil {
+						return err
+					}
+					if _, err := s.wait(ctx)(s.client.UpdateInstancePool(ctx, live.ID, req)); err != nil {
+						return err
+					}
+				}
+
+				if scale {
+					if _, err := s.wait(ctx)(s.client.ScaleInstancePool(ctx, live.ID, v3.ScaleInstancePoolRequest{
+						Size: pool.Size,
+					})); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+		})
+	}
+
+	return nil
+}
+
+func securityGroupIDs(sgs []v3.SecurityGroup) []v3.UUID {
+	ids := make([]v3.UUID, 0, len(sgs))
+	for _, sg := range sgs {
+		ids = append(ids, sg.ID)
+	}
+	return ids
+}
+
+func privateNetworkIDs(pns []v3.PrivateNetwork) []v3.UUID {
+	ids := make([]v3.UUID, 0, len(pns))
+	for _, pn := range pns {
+		ids = append(ids, pn.ID)
+	}
+	return ids
+}
+
+func antiAffinityGroupsChanged(s *manifestState, names []string, current []v3.AntiAffinityGroup) []string {
+	ids := make(map[string]v3.UUID, len(names))
+	for _, name := range names {
+		if aag, err := s.antiAffinityGroup(name); err == nil {
+			ids[name] = aag.ID
+		}
+	}
+
+	currentIDs := make([]v3.UUID, 0, len(current))
+	for _, aag := range current {
+		currentIDs = append(currentIDs, aag.ID)
+	}
+
+	if add, remove := diffReferences(names, ids, currentIDs); len(add)+len(remove) > 0 {
+		return []string{"anti-affinity-groups"}
+	}
+
+	return nil
+}
+
+// manifestInstancePoolUpdateRequest returns the request updating an Instance
+// Pool, retaining the current settings not managed by the manifest.
+func manifestInstancePoolUpdateRequest(
+	s *manifestState,
+	pool manifestInstancePool,
+	live v3.InstancePool,
+	instanceType v3.InstanceType,
+	template v3.Template,
+) (v3.UpdateInstancePoolRequest, error) {
+	req := v3.UpdateInstancePoolRequest{
+		AntiAffinityGroups: make([]v3.AntiAffinityGroup, len(live.AntiAffinityGroups)),
+		ElasticIPS:         make([]v3.ElasticIP, len(live.ElasticIPS)),
+		PrivateNetworks:    make([]v3.PrivateNetwork, len(live.PrivateNetworks)),
+		SecurityGroups:     make([]v3.SecurityGroup, len(live.SecurityGroups)),
+		SSHKeys:            make([]v3.SSHKey, len(live.SSHKeys)),
+	}
+
+	for i, v := range live.AntiAffinityGroups {
+		req.AntiAffinityGroups[i] = v3.AntiAffinityGroup{ID: v.ID}
+	}
+	for i, v := range live.ElasticIPS {
+		req.ElasticIPS[i] = v3.ElasticIP{ID: v.ID}
+	}
+	for i, v := range live.PrivateNetworks {
+		req.PrivateNetworks[i] = v3.PrivateNetwork{ID: v.ID}
+	}
+	for i, v := range live.SecurityGroups {
+		req.SecurityGroups[i] = v3.SecurityGroup{ID: v.ID}
+	}
+	for i, v := range live.SSHKeys {
+		req.SSHKeys[i] = v3.SSHKey{Name: v.Name}
+	}
+	if live.DeployTarget != nil {
+		req.DeployTarget = &v3.DeployTarget{ID: live.DeployTarget.ID}
+	}
+	if live.SSHKey != nil {
+		req.SSHKey = &v3.SSHKey{Name: live.SSHKey.Name}
+	}
+
+	req.Description = pool.Description
+	if pool.Labels != nil {
+		req.Labels = pool.Labels
+	}
+	if pool.InstanceType != "" {
+		req.InstanceType = &v3.InstanceType{ID: instanceType.ID}
+	}
+	if pool.InstancePrefix != "" {
+		req.InstancePrefix = &pool.InstancePrefix
+	}
+	if pool.Template != "" {
+		req.Template = &v3.Template{ID: template.ID}
+	}
+	req.DiskSize = pool.DiskSize
+
+	var err error
+	if pool.SecurityGroups != nil {
+		if req.SecurityGroups, err = s.securityGroupIDs(pool.SecurityGroups); err != nil {
+			return req, err
+		}
+	}
+	if pool.PrivateNetworks != nil {
+		if req.PrivateNetworks, err = s.privateNetworkIDs(pool.PrivateNetworks); err != nil {
+			return req, err
+		}
+	}
+	if pool.AntiAffinityGroups != nil {
+		if req.AntiAffinityGroups, err = s.antiAffinityGroupIDs(pool.AntiAffinityGroups); err != nil {
+			return req, err
+		}
+	}
+
+	return req, nil
+}
+
+func (p *manifestPlanner) planLoadBalancers(_ context.Context) error {
+	for _, lb := range p.m.LoadBalancers {
+		live, err := p.s.loadBalancer(lb.Name)
+		found, err := exists(err)
+		if err != nil {
+			return err
+		}
+
+		if lb.State == manifestStateAbsent {
+			if found {
+				id := live.ID
+				p.add(&manifestChange{
+					Action: manifestActionDelete,
+					Kind:   manifestKindLoadBalancer,
+					Name:   lb.Name,
+					phase:  manifestPhaseDeleteLoadBalancer,
+					apply: func(ctx context.Context, s *manifestState) error {
+						_, err := s.wait(ctx)(s.client.DeleteLoadBalancer(ctx, id))
+						return err
+					},
+				})
+			}
+			continue
+		}
+
+		switch {
+		case !found:
+			p.add(&manifestChange{
+				Action: manifestActionCreate,
+				Kind:   manifestKindLoadBalancer,
+				Name:   lb.Name,
+				phase:  manifestPhaseLoadBalancer,
+				reload: []string{manifestKindLoadBalancer},
+				apply: func(ctx context.Context, s *manifestState) error {
+					_, err := s.wait(ctx)(s.client.CreateLoadBalancer(ctx, v3.CreateLoadBalancerRequest{
+						Name:        lb.Name,
+						Description: lb.Description,
+						Labels:      lb.Labels,
+					}))
+					return err
+				},
+			})
+
+		default:
+			var details []string
+			req := v3.UpdateLoadBalancerRequest{}
+			if lb.Description != "" && lb.Description != live.Description {
+				req.Description = lb.Description
+				details = append(details, "description")
+			}
+			if labelsChanged(lb.Labels, live.Labels) {
+				req.Labels = lb.Labels
+				details = append(details, "labels")
+			}
+			if len(details) > 0 {
+				id := live.ID
+				p.add(&manifestChange{
+					Action:  manifestActionUpdate,
+					Kind:    manifestKindLoadBalancer,
+					Name:    lb.Name,
+					Details: details,
+					phase:   manifestPhaseLoadBalancer,
+					apply: func(ctx context.Context, s *manifestState) error {
+						_, err := s.wait(ctx)(s.client.UpdateLoadBalancer(ctx, id, req))
+						return err
+					},
+				})
+			}
+		}
+
+		if err := p.planLoadBalancerServices(lb, live); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *manifestPlanner) planLoadBalancerServices(lb manifestLoadBalancer, live v3.LoadBalancer) error {
+	current := make(map[string]v3.LoadBalancerService, len(live.Services))
+	for _, svc := range live.Services {
+		current[svc.Name] = svc
+	}
+
+	desired := make(map[string]struct{}, len(lb.Services))
+	for _, svc := range lb.Services {
+		desired[svc.Name] = struct{}{}
+		name := lb.Name + "/" + svc.Name
+
+		poolIDs, err := p.checkReferences(
+			fmt.Sprintf("%s %q", manifestKindLoadBalancerService, name),
+			manifestKindInstancePool,
+			[]string{svc.InstancePool},
+			func(n string) (v3.UUID, error) { r, err := p.s.instancePool(n); return r.ID, err },
+		)
+		if err != nil {
+			return err
+		}
+
+		liveSvc, ok := current[svc.Name]
+		if ok && liveSvc.InstancePool != nil && poolIDs[svc.InstancePool] != liveSvc.InstancePool.ID {
+			// The Instance Pool of a service cannot be changed: the service
+			// has to be replaced.
+			p.add(p.deleteLoadBalancerServiceChange(live.ID, liveSvc, name))
+			ok = false
+		}
+
+		if !ok {
+			p.add(&manifestChange{
+				Action:  manifestActionCreate,
+				Kind:    manifestKindLoadBalancerService,
+				Name:    name,
+				Details: []string{fmt.Sprintf("%s %d -> %s:%d", svc.Protocol, svc.Port, svc.InstancePool, svc.TargetPort)},
+				phase:   manifestPhaseLoadBalancerService,
+				apply: func(ctx context.Context, s *manifestState) error {
+					nlb, err := s.loadBalancer(lb.Name)
+					if err != nil {
+						return err
+					}
+					pool, err := s.instancePool(svc.InstancePool)
+					if err != nil {
+						return err
+					}
+					_, err = s.wait(ctx)(s.client.AddServiceToLoadBalancer(ctx, nlb.ID, v3.AddServiceToLoadBalancerRequest{
+						Name:         svc.Name,
+						Description:  svc.Description,
+						InstancePool: &v3.InstancePool{ID: pool.ID},
+						Port:         svc.Port,
+						TargetPort:   svc.TargetPort,
+						Protocol:     v3.AddServiceToLoadBalancerRequestProtocol(svc.Protocol),
+						Strategy:     v3.AddServiceToLoadBalancerRequestStrategy(svc.Strategy),
+						Healthcheck:  svc.healthcheck(),
+					}))
+					return err
+				},
+			})
+			continue
+		}
+
+		var details []string
+		if svc.Description != "" && svc.Description != liveSvc.Description {
+			details = append(details, "description")
+		}
+		if svc.Port != liveSvc.Port {
+			details = append(details, "port")
+		}
+		if svc.TargetPort != liveSvc.TargetPort {
+			details = append(details, "target-port")
+		}
+		if svc.Protocol != string(liveSvc.Protocol) {
+			details = append(details, "protocol")
+		}
+		if svc.Strategy != string(liveSvc.Strategy) {
+			details = append(details, "strategy")
+		}
+		if liveSvc.Healthcheck == nil || *svc.healthcheck() != *liveSvc.Healthcheck {
+			details = append(details, "healthcheck")
+		}
+		if len(details) == 0 {
+			continue
+		}
+
+		lbID, svcID := live.ID, liveSvc.ID
+		p.add(&manifestChange{
+			Action:  manifestActionUpdate,
+			Kind:    manifestKindLoadBalancerService,
+			Name:    name,
+			Details: details,
+			phase:   manifestPhaseLoadBalancerService,
+			apply: func(ctx context.Context, s *manifestState) error {
+				_, err := s.wait(ctx)(s.client.UpdateLoadBalancerService(ctx, lbID, svcID, v3.UpdateLoadBalancerServiceRequest{
+					Description: svc.Description,
+					Port:        svc.Port,
+					TargetPort:  svc.TargetPort,
+					Protocol:    v3.UpdateLoadBalancerServiceRequestProtocol(svc.Protocol),
+					Strategy:    v3.UpdateLoadBalancerServiceRequestStrategy(svc.Strategy),
+					Healthcheck: svc.healthcheck(),
+				}))
+				return err
+			},
+		})
+	}
+
+	// Services are only managed if listed in the manifest, an empty list
+	// meaning that all the services are to be deleted.
+	if lb.Services == nil {
+		return nil
+	}
+
+	for _, svc := range live.Services {
+		if _, ok := desired[svc.Name]; !ok {
+			p.add(p.deleteLoadBalancerServiceChange(live.ID, svc, lb.Name+"/"+svc.Name))
+		}
+	}
+
+	return nil
+}
+
+func (p *manifestPlanner) deleteLoadBalancerServiceChange(lbID v3.UUID, svc v3.LoadBalancerService, name string) *manifestChange {
+	return &manifestChange{
+		Action: manifestActionDelete,
+		Kind:   manifestKindLoadBalancerService,
+		Name:   name,
+		phase:  manifestPhaseDeleteSubResource,
+		apply: func(ctx context.Context, s *manifestState) error {
+			_, err := s.wait(ctx)(s.client.DeleteLoadBalancerService(ctx, lbID, svc.ID))
+			return err
+		},
+	}
+}
+
+func (s *manifestLoadBalancerService) healthcheck() *v3.LoadBalancerServiceHealthcheck {
+	return &v3.LoadBalancerServiceHealthcheck{
+		Mode:     v3.LoadBalancerServiceHealthcheckMode(s.Healthcheck.Mode),
+		Port:     s.Healthcheck.Port,
+		URI:      s.Healthcheck.URI,
+		Interval: s.Healthcheck.Interval,
+		Timeout:  s.Healthcheck.Timeout,
+		Retries:  s.Healthcheck.Retries,
+		TlsSNI:   s.Healthcheck.TLSSNI,
+	}
+}
+
+func (p *manifestPlanner) planDNSDomains(ctx context.Context) error {
+	for _, domain := range p.m.DNSDomains {
+		live, err := p.s.dnsDomain(domain.Name)
+		found, err := exists(err)
+		if err != nil {
+			return err
+		}
+
+		if domain.State == manifestStateAbsent {
+			if found {
+				id := live.ID
+				p.add(&manifestChange{
+					Action: manifestActionDelete,
+					Kind:   manifestKindDNSDomain,
+					Name:   domain.Name,
+					phase:  manifestPhaseDeleteDNSDomain,
+					apply: func(ctx context.Context, s *manifestState) error {
+						_, err := s.wait(ctx)(s.client.DeleteDNSDomain(ctx, id))
+						return err
+					},
+				})
+			}
+			continue
+		}
+
+		var records []v3.DNSDomainRecord
+		if found {
+			res, err := p.s.client.ListDNSDomainRecords(ctx, live.ID)
+			if err != nil {
+				return fmt.Errorf("error listing %s %q records: %w", manifestKindDNSDomain, domain.Name, err)
+			}
+			records = res.DNSDomainRecords
+		} else {
+			p.add(&manifestChange{
+				Action: manifestActionCreate,
+				Kind:   manifestKindDNSDomain,
+				Name:   domain.Name,
+				phase:  manifestPhaseDNSDomain,
+				reload: []string{manifestKindDNSDomain},
+				apply: func(ctx context.Context, s *manifestState) error {
+					_, err := s.wait(ctx)(s.client.CreateDNSDomain(ctx, v3.CreateDNSDomainRequest{
+						UnicodeName: domain.Name,
+					}))
+					return err
+				},
+			})
+		}
+
+		p.planDNSRecords(domain, live.ID, records)
+	}
+
+	return nil
+}
+
+func (p *manifestPlanner) planDNSRecords(domain manifestDNSDomain, domainID v3.UUID, records []v3.DNSDomainRecord) {
+	// Records are only managed if listed in the manifest, an empty list
+	// meaning that all the (non-system) records are to be deleted.
+	if domain.Records == nil {
+		return
+	}
+
+	current := make(map[string]v3.DNSDomainRecord, len(records))
+	for _, r := range records {
+		if r.SystemRecord != nil && *r.SystemRecord {
+			continue
+		}
+		current[dnsRecordKey(r.Name, string(r.Type), r.Content, r.Priority)] = r
+	}
+
+	desired := make(map[string]struct{}, len(domain.Records))
+	for _, record := range domain.Records {
+		name := strings.TrimSuffix(record.Name, "@")
+		key := dnsRecordKey(name, record.Type, record.Content, record.Priority)
+		desired[key] = struct{}{}
+
+		liveRecord, ok := current[key]
+		switch {
+		case !ok:
+			p.add(&manifestChange{
+				Action:  manifestActionCreate,
+				Kind:    manifestKindDNSRecord,
+				Name:    dnsRecordName(name, domain.Name),
+				Details: []string{record.Type + " " + record.Content},
+				phase:   manifestPhaseDNSRecord,
+				apply: func(ctx context.Context, s *manifestState) error {
+					d, err := s.dnsDomain(domain.Name)
+					if err != nil {
+						return err
+					}
+					_, err = s.wait(ctx)(s.client.CreateDNSDomainRecord(ctx, d.ID, v3.CreateDNSDomainRecordRequest{
+						Name:     name,
+						Type:     v3.CreateDNSDomainRecordRequestType(record.Type),
+						Content:  record.Content,
+						Ttl:      record.TTL,
+						Priority: record.Priority,
+					}))
+					return err
+				},
+			})
+
+		case record.TTL != 0 && record.TTL != liveRecord.Ttl:
+			recordID := liveRecord.ID
+			p.add(&manifestChange{
+				Action:  manifestActionUpdate,
+				Kind:    manifestKindDNSRecord,
+				Name:    dnsRecordName(name, domain.Name),
+				Details: []string{fmt.Sprintf("%s %s ttl %d -> %d", record.Type, record.Content, liveRecord.Ttl, record.TTL)},
+				phase:   manifestPhaseDNSRecord,
+				apply: func(ctx context.Context, s *manifestState) error {
+					_, err := s.wait(ctx)(s.client.UpdateDNSDomainRecord(ctx, domainID, recordID, v3.UpdateDNSDomainRecordRequest{
+						Ttl: record.TTL,
+					}))
+					return err
+				},
+			})
+		}
+	}
+
+	for _, r := range records {
+		if r.SystemRecord != nil && *r.SystemRecord {
+			continue
+		}
+		if _, ok := desired[dnsRecordKey(r.Name, string(r.Type), r.Content, r.Priority)]; ok {
+			continue
+		}
+
+		recordID := r.ID
+		p.add(&manifestChange{
+			Action:  manifestActionDelete,
+			Kind:    manifestKindDNSRecord,
+			Name:    dnsRecordName(r.Name, domain.Name),
+			Details: []string{string(r.Type) + " " + r.Content},
+			phase:   manifestPhaseDeleteSubResource,
+			apply: func(ctx context.Context, s *manifestState) error {
+				_, err := s.wait(ctx)(s.client.DeleteDNSDomainRecord(ctx, domainID, recordID))
+				return err
+			},
+		})
+	}
+}
+
+func dnsRecordKey(name, rType, content string, priority int64) string {
+	return strings.ToLower(fmt.Sprintf("%s|%s|%s|%d", name, rType, strings.TrimSuffix(content, "."), priority))
+}
+
+func dnsRecordName(name, domain string) string {
+	if name == "" {
+		return domain
+	}
+	return name + "." + domain
+}
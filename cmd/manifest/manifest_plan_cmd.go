@@ -0,0 +1,180 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/account"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type manifestPlanItemOutput struct {
+	Action  string `json:"action"`
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Details string `json:"details,omitempty"`
+}
+
+type manifestPlanOutput []manifestPlanItemOutput
+
+func (o *manifestPlanOutput) ToJSON()  { output.JSON(o) }
+func (o *manifestPlanOutput) ToText()  { output.Text(o) }
+func (o *manifestPlanOutput) ToTable() { output.Table(o) }
+
+func newManifestPlanOutput(changes []*manifestChange) *manifestPlanOutput {
+	out := make(manifestPlanOutput, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, manifestPlanItemOutput{
+			Action:  c.Action,
+			Kind:    c.Kind,
+			Name:    c.Name,
+			Details: strings.Join(c.Details, ", "),
+		})
+	}
+
+	return &out
+}
+
+const manifestFormatHelp = `The manifest is a YAML (or JSON) document describing the resources of a
+zone:
+
+    zone: ch-gva-2
+    security-groups:
+      - name: bastion
+        rules:
+          - {flow: ingress, protocol: tcp, port: "22", network: 192.0.2.0/24}
+      - name: web
+        rules:
+          - {flow: ingress, protocol: tcp, port: "443", network: 0.0.0.0/0}
+          - {flow: ingress, protocol: tcp, port: "22", security-group: bastion}
+    private-networks:
+      - {name: backend, start-ip: 10.0.0.10, end-ip: 10.0.0.250, netmask: 255.255.255.0}
+    anti-affinity-groups:
+      - name: web
+    instances:
+      - name: bastion
+        instance-type: standard.small
+        security-groups: [bastion]
+    instance-pools:
+      - name: web
+        size: 3
+        security-groups: [web]
+        private-networks: [backend]
+        anti-affinity-groups: [web]
+    load-balancers:
+      - name: web
+        services:
+          - name: https
+            instance-pool: web
+            port: 443
+            target-port: 443
+            healthcheck: {mode: https, uri: /health}
+    dns-domains:
+      - name: example.net
+        records:
+          - {name: www, type: A, content: 192.0.2.1, ttl: 300}
+
+Resources are matched by name, and references to other resources accept
+names or IDs. Only the attributes set in the manifest are compared with the
+live state of existing resources. The rules of Security Groups, the services
+of Network Load Balancers and the records of DNS domains are managed
+exhaustively when listed in the manifest: unlisted ones are deleted (an
+empty list deletes all of them), whereas they are left untouched if the list
+is omitted. Other resources are only deleted when declared with
+"state: absent".
+
+Differences which can't be reconciled in place, such as the template of an
+existing instance, are reported as "drift" in the plan but not applied.
+
+The zone is the one of the --zone flag if set, else the one of the manifest,
+else the default zone of the current account.`
+
+// planManifestFile loads a manifest file and computes the changes to apply
+// to reconcile the live state of the resources with it.
+func planManifestFile(ctx context.Context, file, zone string) (*manifestState, []*manifestChange, error) {
+	m, err := loadManifest(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if zone == "" {
+		zone = m.Zone
+	}
+	if zone == "" {
+		zone = account.CurrentAccount.DefaultZone
+	}
+
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, v3.ZoneName(zone))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s := newManifestState(client)
+	changes, err := planManifest(ctx, m, s)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s, changes, nil
+}
+
+type planCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"plan"`
+
+	File string `cli-short:"f" cli-usage:"path to the manifest file (\"-\" to read from standard input)"`
+	Zone string `cli-short:"z" cli-usage:"zone (overrides the manifest zone)"`
+}
+
+func (c *planCmd) CmdAliases() []string { return nil }
+
+func (c *planCmd) CmdShort() string {
+	return "Show the changes required to apply a manifest"
+}
+
+func (c *planCmd) CmdLong() string {
+	return fmt.Sprintf(`This command compares the resources described in a manifest with their
+live state, and shows the changes "exo apply" would perform.
+
+%s
+
+Supported output template annotations: %s`,
+		manifestFormatHelp,
+		strings.Join(output.TemplateAnnotations(&manifestPlanItemOutput{}), ", "))
+}
+
+func (c *planCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *planCmd) CmdRun(cmd *cobra.Command, _ []string) error {
+	if c.File == "" {
+		exocmd.CmdExitOnUsageError(cmd, "no manifest file specified")
+	}
+
+	_, changes, err := planManifestFile(exocmd.GContext, c.File, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 && !slices.Contains([]string{"json", "yaml", "csv"}, globalstate.OutputFormat) {
+		fmt.Println("No changes: the live state matches the manifest.")
+		return nil
+	}
+
+	return c.OutputFunc(newManifestPlanOutput(changes), nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(exocmd.RootCmd, &planCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
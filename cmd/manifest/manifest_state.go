@@ -0,0 +1,187 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+// manifestState holds the live state of the resources of a zone, and
+// resolves the resources referenced by name or ID in a manifest.
+type manifestState struct {
+	client *v3.Client
+
+	securityGroups     *v3.ListSecurityGroupsResponse
+	privateNetworks    *v3.ListPrivateNetworksResponse
+	antiAffinityGroups *v3.ListAntiAffinityGroupsResponse
+	instances          *v3.ListInstancesResponse
+	instancePools      *v3.ListInstancePoolsResponse
+	loadBalancers      *v3.ListLoadBalancersResponse
+	dnsDomains         *v3.ListDNSDomainsResponse
+
+	instanceTypes *v3.ListInstanceTypesResponse
+	templates     map[string]*v3.ListTemplatesResponse
+}
+
+func newManifestState(client *v3.Client) *manifestState {
+	return &manifestState{
+		client:    client,
+		templates: make(map[string]*v3.ListTemplatesResponse),
+	}
+}
+
+// load retrieves the live state of the resources of the kinds specified.
+func (s *manifestState) load(ctx context.Context, kinds ...string) error {
+	var err error
+
+	for _, kind := range kinds {
+		switch kind {
+		case manifestKindSecurityGroup:
+			s.securityGroups, err = s.client.ListSecurityGroups(ctx)
+		case manifestKindPrivateNetwork:
+			s.privateNetworks, err = s.client.ListPrivateNetworks(ctx)
+		case manifestKindAntiAffinityGroup:
+			s.antiAffinityGroups, err = s.client.ListAntiAffinityGroups(ctx)
+		case manifestKindInstance:
+			s.instances, err = s.client.ListInstances(ctx)
+		case manifestKindInstancePool:
+			s.instancePools, err = s.client.ListInstancePools(ctx)
+		case manifestKindLoadBalancer:
+			s.loadBalancers, err = s.client.ListLoadBalancers(ctx)
+		case manifestKindDNSDomain:
+			s.dnsDomains, err = s.client.ListDNSDomains(ctx)
+		default:
+			return fmt.Errorf("unsupported resource kind %q", kind)
+		}
+		if err != nil {
+			return fmt.Errorf("error listing %s: %w", kind, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *manifestState) securityGroup(nameOrID string) (v3.SecurityGroup, error) {
+	return s.securityGroups.FindSecurityGroup(nameOrID)
+}
+
+func (s *manifestState) privateNetwork(nameOrID string) (v3.PrivateNetwork, error) {
+	return s.privateNetworks.FindPrivateNetwork(nameOrID)
+}
+
+func (s *manifestState) antiAffinityGroup(nameOrID string) (v3.AntiAffinityGroup, error) {
+	return s.antiAffinityGroups.FindAntiAffinityGroup(nameOrID)
+}
+
+func (s *manifestState) instance(nameOrID string) (v3.ListInstancesResponseInstances, error) {
+	return s.instances.FindListInstancesResponseInstances(nameOrID)
+}
+
+func (s *manifestState) instancePool(nameOrID string) (v3.InstancePool, error) {
+	return s.instancePools.FindInstancePool(nameOrID)
+}
+
+func (s *manifestState) loadBalancer(nameOrID string) (v3.LoadBalancer, error) {
+	return s.loadBalancers.FindLoadBalancer(nameOrID)
+}
+
+func (s *manifestState) dnsDomain(nameOrID string) (v3.DNSDomain, error) {
+	return s.dnsDomains.FindDNSDomain(nameOrID)
+}
+
+// instanceType resolves an instance type expressed as [FAMILY.]SIZE.
+func (s *manifestState) instanceType(ctx context.Context, spec string) (v3.InstanceType, error) {
+	if s.instanceTypes == nil {
+		instanceTypes, err := s.client.ListInstanceTypes(ctx)
+		if err != nil {
+			return v3.InstanceType{}, fmt.Errorf("error listing instance type: %w", err)
+		}
+		s.instanceTypes = instanceTypes
+	}
+
+	instanceType := utils.ParseInstanceType(spec)
+	for _, it := range s.instanceTypes.InstanceTypes {
+		if it.Family == instanceType.Family && it.Size == instanceType.Size {
+			return it, nil
+		}
+	}
+
+	return v3.InstanceType{}, fmt.Errorf("instance type %q not found", spec)
+}
+
+// template resolves a template by name or ID among the templates of the
+// visibility specified.
+func (s *manifestState) template(ctx context.Context, nameOrID, visibility string) (v3.Template, error) {
+	templates, ok := s.templates[visibility]
+	if !ok {
+		var err error
+		templates, err = s.client.ListTemplates(ctx, v3.ListTemplatesWithVisibility(v3.ListTemplatesVisibility(visibility)))
+		if err != nil {
+			return v3.Template{}, fmt.Errorf("error listing template with visibility %q: %w", visibility, err)
+		}
+		s.templates[visibility] = templates
+	}
+
+	template, err := templates.FindTemplate(nameOrID)
+	if err != nil {
+		return v3.Template{}, fmt.Errorf("no template %q found with visibility %s", nameOrID, visibility)
+	}
+
+	return template, nil
+}
+
+// securityGroupIDs resolves a list of Security Groups.
+func (s *manifestState) securityGroupIDs(names []string) ([]v3.SecurityGroup, error) {
+	res := make([]v3.SecurityGroup, 0, len(names))
+	for _, name := range names {
+		sg, err := s.securityGroup(name)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving Security Group: %w", err)
+		}
+		res = append(res, v3.SecurityGroup{ID: sg.ID})
+	}
+
+	return res, nil
+}
+
+// privateNetworkIDs resolves a list of Private Networks.
+func (s *manifestState) privateNetworkIDs(names []string) ([]v3.PrivateNetwork, error) {
+	res := make([]v3.PrivateNetwork, 0, len(names))
+	for _, name := range names {
+		pn, err := s.privateNetwork(name)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving Private Network: %w", err)
+		}
+		res = append(res, v3.PrivateNetwork{ID: pn.ID})
+	}
+
+	return res, nil
+}
+
+// antiAffinityGroupIDs resolves a list of Anti-Affinity Groups.
+func (s *manifestState) antiAffinityGroupIDs(names []string) ([]v3.AntiAffinityGroup, error) {
+	res := make([]v3.AntiAffinityGroup, 0, len(names))
+	for _, name := range names {
+		aag, err := s.antiAffinityGroup(name)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving Anti-Affinity Group: %w", err)
+		}
+		res = append(res, v3.AntiAffinityGroup{ID: aag.ID})
+	}
+
+	return res, nil
+}
+
+// wait returns a function waiting for the completion of the operation
+// returned by an API call.
+func (s *manifestState) wait(ctx context.Context) func(*v3.Operation, error) (*v3.Operation, error) {
+	return func(op *v3.Operation, err error) (*v3.Operation, error) {
+		if err != nil {
+			return nil, err
+		}
+
+		return s.client.Wait(ctx, op, v3.OperationStateSuccess)
+	}
+}
@@ -0,0 +1,262 @@
+package manifest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/testutils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+const testManifest = `
+zone: ch-gva-2
+security-groups:
+  - name: web
+    rules:
+      - {protocol: TCP, port: "443", network: 0.0.0.0/0}
+      - {protocol: tcp, port: "8000-8080", security-group: web}
+private-networks:
+  - {name: backend, start-ip: 10.0.0.10, end-ip: 10.0.0.250, netmask: 255.255.255.0}
+anti-affinity-groups:
+  - {name: legacy, state: absent}
+instances:
+  - name: bastion
+    instance-type: standard.small
+    template: Linux Debian 12
+    security-groups: [web]
+    private-networks: [backend]
+dns-domains:
+  - name: example.net
+    records:
+      - {name: www, type: a, content: 192.0.2.1, ttl: 300}
+      - {name: api, type: A, content: 192.0.2.2}
+`
+
+func TestParseManifest(t *testing.T) {
+	m, err := parseManifest([]byte(testManifest))
+	require.NoError(t, err)
+
+	require.Len(t, m.SecurityGroups, 1)
+	rule := m.SecurityGroups[0].Rules[0]
+	assert.Equal(t, "ingress", rule.Flow)
+	assert.Equal(t, "tcp", rule.Protocol)
+	assert.Equal(t, "0.0.0.0/0", rule.Network)
+	start, end, err := m.SecurityGroups[0].Rules[1].ports()
+	require.NoError(t, err)
+	assert.Equal(t, []int64{8000, 8080}, []int64{start, end})
+	assert.Equal(t, exocmd.DefaultTemplateVisibility, m.Instances[0].TemplateVisibility)
+	assert.Equal(t, "A", m.DNSDomains[0].Records[0].Type)
+}
+
+func TestParseManifestErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		err      string
+	}{
+		{"unknown field", "instances: [{name: a, flavor: small}]", "field flavor not found"},
+		{"missing name", "security-groups: [{description: foo}]", "name must be specified"},
+		{"duplicate", "private-networks: [{name: a}, {name: a}]", "declared more than once"},
+		{"invalid state", "anti-affinity-groups: [{name: a, state: present}]", "invalid state"},
+		{"rule target", "security-groups: [{name: a, rules: [{port: '22'}]}]", "target network address"},
+		{"rule port", "security-groups: [{name: a, rules: [{port: '22-a', network: 0.0.0.0/0}]}]", "invalid port value"},
+		{"pool size", "instance-pools: [{name: a}]", "size must be greater than 0"},
+		{"service uri", "load-balancers: [{name: a, services: [{name: s, instance-pool: p, port: 80, target-port: 80, healthcheck: {mode: http}}]}]", "uri must be specified"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseManifest([]byte(tt.manifest))
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+// newManifestTestServer returns an API server serving the live state of the
+// test resources, with the responses of the paths specified in overrides
+// replaced.
+func newManifestTestServer(t *testing.T, overrides map[string]any) *httptest.Server {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	system := true
+	responses := map[string]any{
+		"/zone": v3.ListZonesResponse{
+			Zones: []v3.Zone{{APIEndpoint: v3.Endpoint(server.URL), Name: "ch-gva-2"}},
+		},
+		"/security-group": v3.ListSecurityGroupsResponse{SecurityGroups: []v3.SecurityGroup{{
+			ID:   "11111111-1111-1111-1111-111111111111",
+			Name: "web",
+			Rules: []v3.SecurityGroupRule{
+				{ID: "21111111-1111-1111-1111-111111111111", FlowDirection: "ingress", Protocol: "tcp", StartPort: 80, EndPort: 80, Network: "0.0.0.0/0"},
+				{
+					ID:            "31111111-1111-1111-1111-111111111111",
+					FlowDirection: "ingress",
+					Protocol:      "tcp",
+					StartPort:     8000,
+					EndPort:       8080,
+					SecurityGroup: &v3.SecurityGroupResource{ID: "11111111-1111-1111-1111-111111111111", Name: "web"},
+				},
+			},
+		}}},
+		"/private-network":     v3.ListPrivateNetworksResponse{},
+		"/anti-affinity-group": v3.ListAntiAffinityGroupsResponse{AntiAffinityGroups: []v3.AntiAffinityGroup{{ID: "41111111-1111-1111-1111-111111111111", Name: "legacy"}}},
+		"/instance":            v3.ListInstancesResponse{},
+		"/instance-pool":       v3.ListInstancePoolsResponse{},
+		"/load-balancer":       v3.ListLoadBalancersResponse{},
+		"/dns-domain":          v3.ListDNSDomainsResponse{DNSDomains: []v3.DNSDomain{{ID: "51111111-1111-1111-1111-111111111111", UnicodeName: "example.net"}}},
+		"/dns-domain/51111111-1111-1111-1111-111111111111/record": v3.ListDNSDomainRecordsResponse{DNSDomainRecords: []v3.DNSDomainRecord{
+			{ID: "61111111-1111-1111-1111-111111111111", Type: "NS", Content: "ns1.exoscale.ch.", SystemRecord: &system},
+			{ID: "71111111-1111-1111-1111-111111111111", Name: "www", Type: "A", Content: "192.0.2.1", Ttl: 3600},
+			{ID: "81111111-1111-1111-1111-111111111111", Name: "old", Type: "A", Content: "192.0.2.9"},
+		}},
+		"/instance-type": v3.ListInstanceTypesResponse{InstanceTypes: []v3.InstanceType{
+			{ID: "91111111-1111-1111-1111-111111111111", Family: "standard", Size: "small"},
+		}},
+		"/template": v3.ListTemplatesResponse{Templates: []v3.Template{
+			{ID: "a1111111-1111-1111-1111-111111111111", Name: "Linux Debian 12"},
+		}},
+	}
+	for path, res := range overrides {
+		responses[path] = res
+	}
+	for path, res := range responses {
+		mux.HandleFunc(path, func(w http.ResponseWriter, _ *http.Request) {
+			testutils.WriteJSON(t, w, http.StatusOK, res)
+		})
+	}
+
+	return server
+}
+
+func TestPlan(t *testing.T) {
+	server := newManifestTestServer(t, nil)
+	defer server.Close()
+	testutils.SetupV3Client(t, server.URL)
+
+	file := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(testManifest), 0o600))
+
+	var out *manifestPlanOutput
+	cmd := &planCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+		File:               file,
+	}
+	cmd.OutputFunc = func(o output.Outputter, err error) error {
+		out = o.(*manifestPlanOutput)
+		return err
+	}
+	require.NoError(t, cmd.CmdRun(nil, nil))
+
+	assert.Equal(t, manifestPlanOutput{
+		{Action: "delete", Kind: manifestKindSecurityGroupRule, Name: "web", Details: "ingress tcp port 80 network 0.0.0.0/0"},
+		{Action: "delete", Kind: manifestKindDNSRecord, Name: "old.example.net", Details: "A 192.0.2.9"},
+		{Action: "delete", Kind: manifestKindAntiAffinityGroup, Name: "legacy"},
+		{Action: "create", Kind: manifestKindSecurityGroupRule, Name: "web", Details: "ingress tcp port 443 network 0.0.0.0/0"},
+		{Action: "create", Kind: manifestKindPrivateNetwork, Name: "backend"},
+		{Action: "create", Kind: manifestKindInstance, Name: "bastion", Details: "type standard.small, template Linux Debian 12"},
+		{Action: "update", Kind: manifestKindDNSRecord, Name: "www.example.net", Details: "A 192.0.2.1 ttl 3600 -> 300"},
+		{Action: "create", Kind: manifestKindDNSRecord, Name: "api.example.net", Details: "A 192.0.2.2"},
+	}, *out)
+}
+
+func TestPlanUnknownReference(t *testing.T) {
+	server := newManifestTestServer(t, nil)
+	defer server.Close()
+	testutils.SetupV3Client(t, server.URL)
+
+	file := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("instances: [{name: a, security-groups: [db]}]"), 0o600))
+
+	_, _, err := planManifestFile(exocmd.GContext, file, "ch-gva-2")
+	assert.ErrorContains(t, err, `instance "a": security-group "db" not found`)
+}
+
+func testPlan(t *testing.T, server *httptest.Server, manifest string) manifestPlanOutput {
+	t.Helper()
+	testutils.SetupV3Client(t, server.URL)
+
+	file := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(manifest), 0o600))
+
+	_, changes, err := planManifestFile(exocmd.GContext, file, "ch-gva-2")
+	require.NoError(t, err)
+
+	return *newManifestPlanOutput(changes)
+}
+
+func TestPlanUnmanagedSubResources(t *testing.T) {
+	server := newManifestTestServer(t, nil)
+	defer server.Close()
+
+	// Omitted lists of sub-resources are not managed.
+	assert.Equal(t, manifestPlanOutput{
+		{Action: "drift", Kind: manifestKindSecurityGroup, Name: "web", Details: "description (requires recreating the Security Group)"},
+	}, testPlan(t, server, `
+security-groups:
+  - {name: web, description: frontend}
+dns-domains:
+  - {name: example.net}
+`))
+
+	// Empty lists delete all the sub-resources.
+	assert.Equal(t, manifestPlanOutput{
+		{Action: "delete", Kind: manifestKindSecurityGroupRule, Name: "web", Details: "ingress tcp port 80 network 0.0.0.0/0"},
+		{Action: "delete", Kind: manifestKindSecurityGroupRule, Name: "web", Details: "ingress tcp port 8000-8080 security-group web"},
+		{Action: "delete", Kind: manifestKindDNSRecord, Name: "www.example.net", Details: "A 192.0.2.1"},
+		{Action: "delete", Kind: manifestKindDNSRecord, Name: "old.example.net", Details: "A 192.0.2.9"},
+	}, testPlan(t, server, `
+security-groups:
+  - {name: web, rules: []}
+dns-domains:
+  - {name: example.net, records: []}
+`))
+}
+
+func TestPlanInstanceDrift(t *testing.T) {
+	const instanceID = "b1111111-1111-1111-1111-111111111111"
+
+	server := newManifestTestServer(t, map[string]any{
+		"/instance": v3.ListInstancesResponse{Instances: []v3.ListInstancesResponseInstances{{
+			ID:           instanceID,
+			Name:         "bastion",
+			InstanceType: &v3.InstanceType{ID: "91111111-1111-1111-1111-111111111111"},
+			Template:     &v3.Template{ID: "a2222222-2222-2222-2222-222222222222"},
+			SSHKeys:      []v3.SSHKey{{Name: "alice"}},
+		}}},
+		"/instance/" + instanceID: v3.Instance{ID: instanceID, Name: "bastion", DiskSize: 50},
+		"/instance-type": v3.ListInstanceTypesResponse{InstanceTypes: []v3.InstanceType{
+			{ID: "91111111-1111-1111-1111-111111111111", Family: "standard", Size: "small"},
+			{ID: "92222222-2222-2222-2222-222222222222", Family: "standard", Size: "medium"},
+		}},
+	})
+	defer server.Close()
+
+	assert.Equal(t, manifestPlanOutput{
+		{Action: "drift", Kind: manifestKindInstance, Name: "bastion", Details: "template (requires recreating the instance), " +
+			"ssh-keys (requires recreating the instance)"},
+		{Action: "update", Kind: manifestKindInstance, Name: "bastion", Details: "instance-type standard.medium, disk-size 100"},
+	}, testPlan(t, server, `
+instances:
+  - name: bastion
+    instance-type: standard.medium
+    template: Linux Debian 12
+    disk-size: 100
+    ssh-keys: [alice, bob]
+`))
+
+	assert.Equal(t, manifestPlanOutput{
+		{Action: "drift", Kind: manifestKindInstance, Name: "bastion", Details: "disk-size (cannot be shrunk from 50 to 20 GB)"},
+	}, testPlan(t, server, `
+instances:
+  - {name: bastion, instance-type: standard.small, disk-size: 20}
+`))
+}
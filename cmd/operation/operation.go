@@ -0,0 +1,50 @@
+package operation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+var operationCmd = &cobra.Command{
+	Use:   "operation",
+	Short: "Asynchronous operations management",
+	Long: `These commands allow to inspect and wait for the asynchronous operations
+returned by the Exoscale API.
+
+When the global --async flag is set, commands stop as soon as they start
+waiting for the completion of the operations they submitted to the API, and
+print these operations (with their zone) instead of the resulting resources.
+Commands chaining several steps depending on each other don't perform the
+steps following their first submission, which is reported on the standard
+error. Use "exo operation wait" to wait for the submitted operations to
+complete.`,
+	Aliases: []string{"op"},
+}
+
+// parseOperationRef parses an operation reference in the form [ZONE/]ID, as
+// printed in --async mode. The zone specified is used if the reference
+// doesn't include one.
+func parseOperationRef(ref, zone string) (v3.ZoneName, v3.UUID, error) {
+	if z, id, ok := strings.Cut(ref, "/"); ok {
+		zone, ref = z, id
+	}
+
+	id, err := v3.ParseUUID(ref)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid operation ID %q: %w", ref, err)
+	}
+	if zone == "" {
+		return "", "", fmt.Errorf("no zone specified for operation %s", id)
+	}
+
+	return v3.ZoneName(zone), id, nil
+}
+
+func init() {
+	exocmd.RootCmd.AddCommand(operationCmd)
+}
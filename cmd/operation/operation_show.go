@@ -0,0 +1,99 @@
+package operation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type operationShowOutput struct {
+	ID               v3.UUID           `json:"id"`
+	Zone             v3.ZoneName       `json:"zone"`
+	State            v3.OperationState `json:"state"`
+	Reason           string            `json:"reason,omitempty"`
+	Message          string            `json:"message,omitempty"`
+	ReferenceID      v3.UUID           `json:"reference_id,omitempty" outputLabel:"Reference ID"`
+	ReferenceCommand string            `json:"reference_command,omitempty"`
+	ReferenceLink    string            `json:"reference_link,omitempty"`
+}
+
+func (o *operationShowOutput) ToJSON()  { output.JSON(o) }
+func (o *operationShowOutput) ToText()  { output.Text(o) }
+func (o *operationShowOutput) ToTable() { output.Table(o) }
+
+func newOperationShowOutput(op *v3.Operation, zone v3.ZoneName) *operationShowOutput {
+	out := operationShowOutput{
+		ID:      op.ID,
+		Zone:    zone,
+		State:   op.State,
+		Reason:  string(op.Reason),
+		Message: op.Message,
+	}
+
+	if op.Reference != nil {
+		out.ReferenceID = op.Reference.ID
+		out.ReferenceCommand = op.Reference.Command
+		out.ReferenceLink = op.Reference.Link
+	}
+
+	return &out
+}
+
+type operationShowCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"show"`
+
+	ID string `cli-arg:"#" cli-usage:"[ZONE/]ID"`
+
+	Zone string `cli-short:"z" cli-usage:"operation zone (ignored if specified in the operation reference)"`
+}
+
+func (c *operationShowCmd) CmdAliases() []string { return exocmd.GShowAlias }
+
+func (c *operationShowCmd) CmdShort() string { return "Show an operation details" }
+
+func (c *operationShowCmd) CmdLong() string {
+	return fmt.Sprintf(`This command shows an asynchronous operation details. The operation can be
+referenced either by its ID, or in the ZONE/ID form.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&operationShowOutput{}), ", "))
+}
+
+func (c *operationShowCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *operationShowCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	zone, id, err := parseOperationRef(c.ID, c.Zone)
+	if err != nil {
+		return err
+	}
+
+	ctx := exocmd.GContext
+	client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, zone)
+	if err != nil {
+		return err
+	}
+
+	op, err := client.GetOperation(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.OutputFunc(newOperationShowOutput(op, zone), nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(operationCmd, &operationShowCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,131 @@
+package operation
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/pkg/testutils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+const (
+	testOperationID       = "11111111-1111-1111-1111-111111111111"
+	testFailedOperationID = "33333333-3333-3333-3333-333333333333"
+)
+
+func newOperationTestServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	mux.HandleFunc("/zone", func(w http.ResponseWriter, _ *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.ListZonesResponse{
+			Zones: []v3.Zone{
+				{APIEndpoint: v3.Endpoint(server.URL), Name: "ch-gva-2"},
+				{APIEndpoint: v3.Endpoint(server.URL), Name: "de-fra-1"},
+			},
+		})
+	})
+	mux.HandleFunc("/operation/"+testOperationID, func(w http.ResponseWriter, _ *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{
+			ID:        testOperationID,
+			State:     v3.OperationStateSuccess,
+			Reference: &v3.OperationReference{ID: "22222222-2222-2222-2222-222222222222", Command: "start-instance"},
+		})
+	})
+	mux.HandleFunc("/operation/"+testFailedOperationID, func(w http.ResponseWriter, _ *http.Request) {
+		testutils.WriteJSON(t, w, http.StatusOK, v3.Operation{
+			ID:     testFailedOperationID,
+			State:  v3.OperationStateFailure,
+			Reason: v3.OperationReasonUnavailable,
+		})
+	})
+
+	return server
+}
+
+func TestOperationShow(t *testing.T) {
+	server := newOperationTestServer(t)
+	defer server.Close()
+	testutils.SetupV3Client(t, server.URL)
+
+	var out *operationShowOutput
+	cmd := &operationShowCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+		ID:                 testOperationID,
+		Zone:               "ch-gva-2",
+	}
+	cmd.OutputFunc = func(o output.Outputter, err error) error {
+		out = o.(*operationShowOutput)
+		return err
+	}
+	require.NoError(t, cmd.CmdRun(nil, nil))
+	assert.Equal(t, v3.OperationStateSuccess, out.State)
+	assert.Equal(t, v3.ZoneName("ch-gva-2"), out.Zone)
+	assert.Equal(t, "start-instance", out.ReferenceCommand)
+
+	cmd.ID = "de-fra-1/" + testOperationID
+	require.NoError(t, cmd.CmdRun(nil, nil))
+	assert.Equal(t, v3.ZoneName("de-fra-1"), out.Zone)
+
+	cmd.ID = "not-an-uuid"
+	assert.ErrorContains(t, cmd.CmdRun(nil, nil), "invalid operation ID")
+}
+
+func TestOperationWait(t *testing.T) {
+	server := newOperationTestServer(t)
+	defer server.Close()
+	testutils.SetupV3Client(t, server.URL)
+	globalstate.Quiet = false
+	defer func() { globalstate.Quiet = true }()
+
+	var out *operationWaitOutput
+	cmd := &operationWaitCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+		IDs:                []string{testOperationID},
+		Zone:               "ch-gva-2",
+	}
+	cmd.OutputFunc = func(o output.Outputter, err error) error {
+		out = o.(*operationWaitOutput)
+		return err
+	}
+	require.NoError(t, cmd.CmdRun(nil, nil))
+	require.Len(t, *out, 1)
+	assert.Equal(t, v3.UUID(testOperationID), (*out)[0].ID)
+
+	// Operations submitted in different zones.
+	cmd.IDs = []string{testOperationID, "de-fra-1/" + testOperationID}
+	require.NoError(t, cmd.CmdRun(nil, nil))
+	require.Len(t, *out, 2)
+	assert.Equal(t, v3.ZoneName("ch-gva-2"), (*out)[0].Zone)
+	assert.Equal(t, v3.ZoneName("de-fra-1"), (*out)[1].Zone)
+
+	cmd.IDs = []string{testOperationID, testFailedOperationID}
+	err := cmd.CmdRun(nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), testFailedOperationID)
+	assert.Contains(t, err.Error(), "failure")
+}
+
+func TestParseOperationRef(t *testing.T) {
+	zone, id, err := parseOperationRef(testOperationID, "ch-gva-2")
+	require.NoError(t, err)
+	assert.Equal(t, v3.ZoneName("ch-gva-2"), zone)
+	assert.Equal(t, v3.UUID(testOperationID), id)
+
+	zone, _, err = parseOperationRef("de-fra-1/"+testOperationID, "ch-gva-2")
+	require.NoError(t, err)
+	assert.Equal(t, v3.ZoneName("de-fra-1"), zone)
+
+	_, _, err = parseOperationRef(testOperationID, "")
+	assert.ErrorContains(t, err, "no zone specified")
+
+	_, _, err = parseOperationRef("de-fra-1/not-an-uuid", "")
+	assert.ErrorContains(t, err, "invalid operation ID")
+}
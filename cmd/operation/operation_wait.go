@@ -0,0 +1,147 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type operationWaitOutput []operationShowOutput
+
+func (o *operationWaitOutput) ToJSON()  { output.JSON(o) }
+func (o *operationWaitOutput) ToText()  { output.Text(o) }
+func (o *operationWaitOutput) ToTable() { output.Table(o) }
+
+type operationWaitCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"wait"`
+
+	IDs []string `cli-arg:"*" cli-usage:"[ZONE/]ID"`
+
+	WaitTimeout int64  `cli-usage:"maximum duration to wait in seconds (0 to wait indefinitely)"`
+	Zone        string `cli-short:"z" cli-usage:"operations zone (ignored for operations referenced as ZONE/ID)"`
+}
+
+func (c *operationWaitCmd) CmdAliases() []string { return nil }
+
+func (c *operationWaitCmd) CmdShort() string { return "Wait for operations to complete" }
+
+func (c *operationWaitCmd) CmdLong() string {
+	return fmt.Sprintf(`This command waits for asynchronous operations to complete, and fails if
+any of them doesn't succeed.
+
+Operations can be referenced either by their ID, in which case they are
+looked up in the zone specified with the --zone flag, or in the ZONE/ID form
+allowing to wait for operations submitted in different zones, e.g.:
+
+	exo operation wait $(exo --async compute instance start -O text \
+		--output-template '{{.Zone}}/{{.ID}}' my-instance)
+
+The --wait-timeout flag sets the maximum duration to wait in seconds (0, the
+default, waits indefinitely).
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&operationShowOutput{}), ", "))
+}
+
+func (c *operationWaitCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	exocmd.CmdSetZoneFlagFromDefault(cmd)
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *operationWaitCmd) CmdRun(cmd *cobra.Command, _ []string) error {
+	if len(c.IDs) == 0 {
+		exocmd.CmdExitOnUsageError(cmd, "no operation ID specified")
+	}
+
+	if c.WaitTimeout < 0 {
+		return fmt.Errorf("invalid wait timeout %d", c.WaitTimeout)
+	}
+
+	type operationRef struct {
+		zone v3.ZoneName
+		id   v3.UUID
+	}
+	refs := make([]operationRef, 0, len(c.IDs))
+	for _, s := range c.IDs {
+		zone, id, err := parseOperationRef(s, c.Zone)
+		if err != nil {
+			return err
+		}
+		refs = append(refs, operationRef{zone: zone, id: id})
+	}
+
+	ctx := exocmd.GContext
+	if c.WaitTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(c.WaitTimeout)*time.Second)
+		defer cancel()
+	}
+
+	clients := make(map[v3.ZoneName]*v3.Client)
+	for _, ref := range refs {
+		if _, ok := clients[ref.zone]; ok {
+			continue
+		}
+		client, err := exocmd.SwitchClientZoneV3(ctx, globalstate.EgoscaleV3Client, ref.zone)
+		if err != nil {
+			return err
+		}
+		clients[ref.zone] = client
+	}
+
+	out := make(operationWaitOutput, len(refs))
+	fns := make([]func() error, 0, len(refs))
+	for i, ref := range refs {
+		client, id := clients[ref.zone], ref.id
+		fns = append(fns, func() error {
+			op, err := client.GetOperation(ctx, id)
+			if err != nil {
+				return fmt.Errorf("operation %s: %w", id, err)
+			}
+
+			done, err := client.Wait(ctx, op, v3.OperationStateSuccess)
+			if err != nil {
+				if ctx.Err() == context.DeadlineExceeded {
+					return fmt.Errorf("operation %s: timeout reached after %ds", id, c.WaitTimeout)
+				}
+				return err
+			}
+
+			// Wait() returns operations already completed as-is, regardless
+			// of their final state.
+			if done.State != v3.OperationStateSuccess {
+				return fmt.Errorf("operation %s: state: %s, reason: %q, message: %q", id, done.State, done.Reason, done.Message)
+			}
+			out[i] = *newOperationShowOutput(done, ref.zone)
+
+			return nil
+		})
+	}
+
+	if err := utils.DecorateAsyncOperations(fmt.Sprintf("Waiting for %d operation(s)...", len(refs)), fns...); err != nil {
+		return err
+	}
+
+	if !globalstate.Quiet {
+		return c.OutputFunc(&out, nil)
+	}
+
+	return nil
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(operationCmd, &operationWaitCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
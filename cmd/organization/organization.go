@@ -0,0 +1,38 @@
+package organization
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+)
+
+var organizationCmd = &cobra.Command{
+	Use:     "organization",
+	Short:   "Organization billing and consumption",
+	Aliases: []string{"org", "billing"},
+}
+
+func init() {
+	exocmd.RootCmd.AddCommand(organizationCmd)
+}
+
+// reportPeriodLayout is the layout of the billing periods expected by the
+// reporting API endpoints (e.g. "2024-03").
+const reportPeriodLayout = "2006-01"
+
+// validateReportPeriod checks that period is a valid billing period, and
+// returns the current one if empty.
+func validateReportPeriod(period string) (string, error) {
+	if period == "" {
+		return time.Now().UTC().Format(reportPeriodLayout), nil
+	}
+
+	if _, err := time.Parse(reportPeriodLayout, period); err != nil {
+		return "", fmt.Errorf("invalid period %q, expected format YYYY-MM", period)
+	}
+
+	return period, nil
+}
@@ -0,0 +1,69 @@
+package organization
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+)
+
+type organizationAIQuotaOutput struct {
+	QuotaUOMPerMinute string `json:"quota_uom_per_minute"`
+}
+
+func (o *organizationAIQuotaOutput) Type() string { return "AI consumption quota" }
+func (o *organizationAIQuotaOutput) ToJSON()      { output.JSON(o) }
+func (o *organizationAIQuotaOutput) ToText()      { output.Text(o) }
+func (o *organizationAIQuotaOutput) ToTable()     { output.Table(o) }
+
+type organizationAIQuotaCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"ai-quota"`
+}
+
+func (c *organizationAIQuotaCmd) CmdAliases() []string { return nil }
+
+func (c *organizationAIQuotaCmd) CmdShort() string {
+	return "Show the organization AI consumption quota"
+}
+
+func (c *organizationAIQuotaCmd) CmdLong() string {
+	return fmt.Sprintf(`This command shows the AI workloads consumption quota of the organization,
+expressed in Units Of Measurement (UOM) per minute. UOM represents weighted
+units across different AI workloads (e.g. tokens for LLMs, minutes for TTS,
+pages for OCR).
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&organizationAIQuotaOutput{}), ", "))
+}
+
+func (c *organizationAIQuotaCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *organizationAIQuotaCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+
+	quota, err := globalstate.EgoscaleV3Client.GetUserOrgConsumptionQuota(ctx)
+	if err != nil {
+		return err
+	}
+
+	out := organizationAIQuotaOutput{QuotaUOMPerMinute: "unlimited"}
+	if quota.QuotaUomPerMinute > 0 {
+		out.QuotaUOMPerMinute = fmt.Sprint(quota.QuotaUomPerMinute)
+	}
+
+	return c.OutputFunc(&out, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(organizationCmd, &organizationAIQuotaCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
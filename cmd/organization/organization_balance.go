@@ -0,0 +1,63 @@
+package organization
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+)
+
+type organizationBalanceOutput struct {
+	Balance  float64 `json:"balance"`
+	Currency string  `json:"currency"`
+}
+
+func (o *organizationBalanceOutput) Type() string { return "Balance" }
+func (o *organizationBalanceOutput) ToJSON()      { output.JSON(o) }
+func (o *organizationBalanceOutput) ToText()      { output.Text(o) }
+func (o *organizationBalanceOutput) ToTable()     { output.Table(o) }
+
+type organizationBalanceCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"balance"`
+}
+
+func (c *organizationBalanceCmd) CmdAliases() []string { return nil }
+
+func (c *organizationBalanceCmd) CmdShort() string { return "Show the organization live balance" }
+
+func (c *organizationBalanceCmd) CmdLong() string {
+	return fmt.Sprintf(`This command shows the current balance of the organization.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&organizationBalanceOutput{}), ", "))
+}
+
+func (c *organizationBalanceCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *organizationBalanceCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+
+	balance, err := globalstate.EgoscaleV3Client.GetLiveBalance(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.OutputFunc(&organizationBalanceOutput{
+		Balance:  balance.Balance,
+		Currency: balance.Currency,
+	}, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(organizationCmd, &organizationBalanceCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,118 @@
+package organization
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/table"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type organizationEnvImpactItemOutput struct {
+	Product   string  `json:"product"`
+	Indicator string  `json:"indicator"`
+	Amount    float64 `json:"amount"`
+	Unit      string  `json:"unit"`
+}
+
+type organizationEnvImpactOutput struct {
+	Period   string                            `json:"period"`
+	Metadata map[string]string                 `json:"metadata"`
+	Impacts  []organizationEnvImpactItemOutput `json:"impacts"`
+}
+
+func (o *organizationEnvImpactOutput) ToJSON() { output.JSON(o) }
+func (o *organizationEnvImpactOutput) ToText() { output.Text(o) }
+func (o *organizationEnvImpactOutput) ToTable() {
+	t := table.NewTable(os.Stdout)
+	t.SetHeader([]string{"Product", "Indicator", "Amount", "Unit"})
+	defer t.Render()
+
+	for _, i := range o.Impacts {
+		t.Append([]string{i.Product, i.Indicator, fmt.Sprintf("%g", i.Amount), i.Unit})
+	}
+}
+
+type organizationEnvImpactCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"env-impact"`
+
+	Period string `cli-usage:"billing period to report on (format: YYYY-MM, default: current period)"`
+}
+
+func (c *organizationEnvImpactCmd) CmdAliases() []string { return []string{"carbon-footprint"} }
+
+func (c *organizationEnvImpactCmd) CmdShort() string {
+	return "Show the organization environmental impact report [BETA]"
+}
+
+func (c *organizationEnvImpactCmd) CmdLong() string {
+	return fmt.Sprintf(`This command shows the environmental impact (e.g. carbon footprint) report
+of the organization for a billing period, per product.
+
+Supported output template annotations: %s
+
+Supported output template annotations for impacts: %s`,
+		strings.Join(output.TemplateAnnotations(&organizationEnvImpactOutput{}), ", "),
+		strings.Join(output.TemplateAnnotations(&organizationEnvImpactItemOutput{}), ", "))
+}
+
+func (c *organizationEnvImpactCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *organizationEnvImpactCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+
+	period, err := validateReportPeriod(c.Period)
+	if err != nil {
+		return err
+	}
+
+	report, err := globalstate.EgoscaleV3Client.GetEnvImpact(ctx, period)
+	if err != nil {
+		return err
+	}
+
+	return c.OutputFunc(envImpactOutput(period, report), nil)
+}
+
+// envImpactOutput flattens an environmental impact report into one row per
+// product indicator.
+func envImpactOutput(period string, report *v3.EnvImpactReport) *organizationEnvImpactOutput {
+	out := organizationEnvImpactOutput{
+		Period:   period,
+		Metadata: make(map[string]string),
+		Impacts:  make([]organizationEnvImpactItemOutput, 0),
+	}
+
+	for _, m := range report.Metadata {
+		out.Metadata[m.Value] = strings.TrimSpace(fmt.Sprintf("%g %s", m.Amount, m.Unit))
+	}
+
+	for _, p := range report.Products {
+		for _, i := range p.Impacts {
+			out.Impacts = append(out.Impacts, organizationEnvImpactItemOutput{
+				Product:   p.Value,
+				Indicator: i.Value,
+				Amount:    i.Amount,
+				Unit:      i.Unit,
+			})
+		}
+	}
+
+	return &out
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(organizationCmd, &organizationEnvImpactCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
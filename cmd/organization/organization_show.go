@@ -0,0 +1,75 @@
+package organization
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type organizationShowOutput struct {
+	ID       v3.UUID `json:"id"`
+	Name     string  `json:"name"`
+	Address  string  `json:"address"`
+	Postcode string  `json:"postcode"`
+	City     string  `json:"city"`
+	Country  string  `json:"country"`
+	Currency string  `json:"currency"`
+}
+
+func (o *organizationShowOutput) Type() string { return "Organization" }
+func (o *organizationShowOutput) ToJSON()      { output.JSON(o) }
+func (o *organizationShowOutput) ToText()      { output.Text(o) }
+func (o *organizationShowOutput) ToTable()     { output.Table(o) }
+
+type organizationShowCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"show"`
+}
+
+func (c *organizationShowCmd) CmdAliases() []string { return exocmd.GShowAlias }
+
+func (c *organizationShowCmd) CmdShort() string { return "Show the current organization details" }
+
+func (c *organizationShowCmd) CmdLong() string {
+	return fmt.Sprintf(`This command shows the details of the organization the current account
+belongs to.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&organizationShowOutput{}), ", "))
+}
+
+func (c *organizationShowCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *organizationShowCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+
+	org, err := globalstate.EgoscaleV3Client.GetOrganization(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.OutputFunc(&organizationShowOutput{
+		ID:       org.ID,
+		Name:     org.Name,
+		Address:  org.Address,
+		Postcode: org.Postcode,
+		City:     org.City,
+		Country:  org.Country,
+		Currency: org.Currency,
+	}, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(organizationCmd, &organizationShowCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -0,0 +1,47 @@
+package organization
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+func TestValidateReportPeriod(t *testing.T) {
+	p, err := validateReportPeriod("")
+	require.NoError(t, err)
+	assert.Equal(t, time.Now().UTC().Format(reportPeriodLayout), p)
+
+	p, err = validateReportPeriod("2024-03")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03", p)
+
+	for _, invalid := range []string{"2024-3", "2024-13", "March 2024", "2024-03-01"} {
+		_, err = validateReportPeriod(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestEnvImpactOutput(t *testing.T) {
+	out := envImpactOutput("2024-03", &v3.EnvImpactReport{
+		Metadata: []v3.EnvMetadataEntry{{Value: "total", Amount: 12.5, Unit: "kgCO2eq"}},
+		Products: []v3.EnvProduct{
+			{
+				Value: "compute",
+				Impacts: []v3.EnvImpactIndicator{
+					{Value: "gwp", Amount: 10, Unit: "kgCO2eq"},
+					{Value: "adpe", Amount: 0.01, Unit: "kgSbeq"},
+				},
+			},
+			{Value: "sos"},
+		},
+	})
+
+	assert.Equal(t, "2024-03", out.Period)
+	assert.Equal(t, map[string]string{"total": "12.5 kgCO2eq"}, out.Metadata)
+	require.Len(t, out.Impacts, 2)
+	assert.Equal(t, organizationEnvImpactItemOutput{Product: "compute", Indicator: "gwp", Amount: 10, Unit: "kgCO2eq"}, out.Impacts[0])
+}
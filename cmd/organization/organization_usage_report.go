@@ -0,0 +1,94 @@
+package organization
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	exocmd "github.com/exoscale/cli/cmd"
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type organizationUsageReportItemOutput struct {
+	Product     string `json:"product"`
+	Variable    string `json:"variable"`
+	Description string `json:"description"`
+	Quantity    string `json:"quantity"`
+	Unit        string `json:"unit"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+}
+
+type organizationUsageReportOutput []organizationUsageReportItemOutput
+
+func (o *organizationUsageReportOutput) ToJSON()  { output.JSON(o) }
+func (o *organizationUsageReportOutput) ToText()  { output.Text(o) }
+func (o *organizationUsageReportOutput) ToTable() { output.Table(o) }
+
+type organizationUsageReportCmd struct {
+	exocmd.CliCommandSettings `cli-cmd:"-"`
+
+	_ bool `cli-cmd:"usage-report"`
+
+	Period string `cli-usage:"billing period to report on (format: YYYY-MM, default: current period)"`
+}
+
+func (c *organizationUsageReportCmd) CmdAliases() []string { return []string{"usage"} }
+
+func (c *organizationUsageReportCmd) CmdShort() string {
+	return "Show the organization usage report"
+}
+
+func (c *organizationUsageReportCmd) CmdLong() string {
+	return fmt.Sprintf(`This command shows the aggregated usage report of the organization for a
+billing period. Use the "csv" output format ("-O csv") to export it as CSV.
+
+Supported output template annotations: %s`,
+		strings.Join(output.TemplateAnnotations(&organizationUsageReportItemOutput{}), ", "))
+}
+
+func (c *organizationUsageReportCmd) CmdPreRun(cmd *cobra.Command, args []string) error {
+	return exocmd.CliCommandDefaultPreRun(c, cmd, args)
+}
+
+func (c *organizationUsageReportCmd) CmdRun(_ *cobra.Command, _ []string) error {
+	ctx := exocmd.GContext
+
+	opts := make([]v3.GetUsageReportOpt, 0)
+	if c.Period != "" {
+		period, err := validateReportPeriod(c.Period)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, v3.GetUsageReportWithPeriod(period))
+	}
+
+	report, err := globalstate.EgoscaleV3Client.GetUsageReport(ctx, opts...)
+	if err != nil {
+		return err
+	}
+
+	out := make(organizationUsageReportOutput, 0, len(report.Usage))
+	for _, u := range report.Usage {
+		out = append(out, organizationUsageReportItemOutput{
+			Product:     u.Product,
+			Variable:    u.Variable,
+			Description: u.Description,
+			Quantity:    u.Quantity,
+			Unit:        u.Unit,
+			From:        u.From,
+			To:          u.To,
+		})
+	}
+
+	return c.OutputFunc(&out, nil)
+}
+
+func init() {
+	cobra.CheckErr(exocmd.RegisterCLICommand(organizationCmd, &organizationUsageReportCmd{
+		CliCommandSettings: exocmd.DefaultCLICmdSettings(),
+	}))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/exoscale/cli/pkg/account"
 	"github.com/exoscale/cli/pkg/globalstate"
 	"github.com/exoscale/cli/pkg/output"
+	"github.com/exoscale/cli/utils"
 	v3 "github.com/exoscale/egoscale/v3"
 )
 
@@ -50,6 +51,16 @@ var RootCmd = &cobra.Command{
 		if globalstate.RequestTimeout != -time.Second && globalstate.RequestTimeout <= 0 {
 			return fmt.Errorf("--timeout must be a positive duration (e.g. 15s), or -1s to disable")
 		}
+		if globalstate.Query != "" {
+			if _, err := output.CompileQuery(globalstate.Query); err != nil {
+				return err
+			}
+		}
+		if globalstate.SortBy != "" {
+			if _, _, err := output.ParseSortBy(globalstate.SortBy); err != nil {
+				return err
+			}
+		}
 		return nil
 	},
 }
@@ -84,8 +95,21 @@ func Execute(version, commit string) {
 	}()
 
 	GContext = ctx
+	utils.SetAsyncCancel(cancel)
+
+	err := RootCmd.Execute()
 
-	if err := RootCmd.Execute(); err != nil {
+	// In --async mode, commands are stopped once their operations have been
+	// submitted: whatever error was caused by stopping them, these operations
+	// are the command output.
+	if utils.AsyncStopped() {
+		if err := utils.PrintAsyncOperations(); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		}
+		return
+	}
+
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "error: %s\n", formatError(err))
 
 		os.Exit(1) //nolint:gocritic
@@ -163,9 +187,14 @@ func init() {
 
 	RootCmd.PersistentFlags().StringVarP(&GConfigFilePath, "config", "C", "", "Specify an alternate config file [env EXOSCALE_CONFIG]")
 	RootCmd.PersistentFlags().StringVarP(&gAccountName, "use-account", "A", "", "Account to use in config file [env EXOSCALE_ACCOUNT]")
-	RootCmd.PersistentFlags().StringVarP(&globalstate.OutputFormat, "output-format", "O", "", "Output format (table|json|text), see \"exo output --help\" for more information")
+	RootCmd.PersistentFlags().StringVarP(&globalstate.OutputFormat, "output-format", "O", "", "Output format (table|json|yaml|csv|text), see \"exo output --help\" for more information")
 	RootCmd.PersistentFlags().StringVar(&output.GOutputTemplate, "output-template", "", "Template to use if output format is \"text\"")
+	RootCmd.PersistentFlags().StringVar(&globalstate.Query, "query", "", "JMESPath expression filtering the JSON form of the output, see \"exo output --help\" for more information")
+	RootCmd.PersistentFlags().StringSliceVar(&globalstate.Columns, "columns", nil, "Comma-separated list of columns to display in \"table\", \"csv\" and \"text\" output formats")
+	RootCmd.PersistentFlags().StringVar(&globalstate.SortBy, "sort-by", "", "Sort list outputs by field, in the form FIELD[:desc]")
+	RootCmd.PersistentFlags().BoolVar(&globalstate.NoHeaders, "no-headers", false, "Don't print headers in \"table\" and \"csv\" output formats")
 	RootCmd.PersistentFlags().BoolVarP(&globalstate.Quiet, "quiet", "Q", false, "Quiet mode (disable non-essential command output)")
+	RootCmd.PersistentFlags().BoolVar(&globalstate.Async, "async", false, "Return the submitted operations immediately instead of waiting for their completion")
 	RootCmd.PersistentFlags().DurationVar(&globalstate.RequestTimeout, "timeout", 15*time.Second, "Per-zone timeout for list operations; -1s disables timeout [env EXOSCALE_TIMEOUT]")
 	RootCmd.AddCommand(versionCmd)
 
@@ -259,7 +288,7 @@ func initConfig() { //nolint:gocyclo
 		GConfig.AddConfigPath(".")
 	}
 
-	nonCredentialCmds := []string{"config", "version", "status"}
+	nonCredentialCmds := []string{"config", "version", "status", cobra.ShellCompRequestCmd}
 
 	file, err := loadFileSources(GConfig, gAccountName)
 	if err != nil {
@@ -22,12 +22,17 @@ import (
 	_ "github.com/exoscale/cli/cmd/compute/security_group"
 	_ "github.com/exoscale/cli/cmd/compute/sks"
 	_ "github.com/exoscale/cli/cmd/compute/ssh_key"
+	_ "github.com/exoscale/cli/cmd/compute/vpc"
 	_ "github.com/exoscale/cli/cmd/config"
 	_ "github.com/exoscale/cli/cmd/dbaas"
 	_ "github.com/exoscale/cli/cmd/dns"
+	_ "github.com/exoscale/cli/cmd/events"
 	_ "github.com/exoscale/cli/cmd/iam"
 	_ "github.com/exoscale/cli/cmd/kms"
 	_ "github.com/exoscale/cli/cmd/kms/crypto"
 	_ "github.com/exoscale/cli/cmd/kms/key"
+	_ "github.com/exoscale/cli/cmd/manifest"
+	_ "github.com/exoscale/cli/cmd/operation"
+	_ "github.com/exoscale/cli/cmd/organization"
 	_ "github.com/exoscale/cli/cmd/storage"
 )
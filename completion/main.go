@@ -15,7 +15,7 @@ func main() {
 	var err error
 	switch os.Args[1] {
 	case "bash":
-		err = cmd.RootCmd.GenBashCompletionFile("bash_completion")
+		err = cmd.RootCmd.GenBashCompletionFileV2("bash_completion", true)
 
 	case "fish":
 		err = cmd.RootCmd.GenFishCompletionFile("fish_completion", true)
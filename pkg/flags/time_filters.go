@@ -1,6 +1,7 @@
 package flags
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -139,3 +140,25 @@ func newerThanTimestampFilter(cmd *cobra.Command) (object.ObjectFilterFunc, erro
 
 	return object.NewerThanFilterFunc(timestamp), nil
 }
+
+// ParseTimeBound returns the point in time described either by a duration
+// relative to now (in the format of Go's time.ParseDuration) or by an ISO 8601
+// timestamp. The zero time is returned if both values are empty.
+func ParseTimeBound(duration, timestamp string, now time.Time) (time.Time, error) {
+	switch {
+	case duration != "" && timestamp != "":
+		return time.Time{}, fmt.Errorf("a duration and a timestamp cannot be specified together")
+
+	case duration != "":
+		dur, err := time.ParseDuration(duration)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return now.Add(-dur), nil
+
+	case timestamp != "":
+		return parseTimestamp(timestamp)
+	}
+
+	return time.Time{}, nil
+}
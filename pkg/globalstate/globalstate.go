@@ -8,8 +8,13 @@ import (
 
 var (
 	OutputFormat          string
+	Query                 string
+	Columns               []string
+	SortBy                string
+	NoHeaders             bool
 	EgoscaleV3Client      *v3.Client
 	Quiet                 bool
+	Async                 bool
 	ConfigFolder          string
 	GitVersion, GitCommit string
 	RequestTimeout        time.Duration
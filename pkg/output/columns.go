@@ -0,0 +1,284 @@
+package output
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fatih/camelcase"
+
+	"github.com/exoscale/cli/pkg/globalstate"
+)
+
+// outputFields returns the indexes of the fields of t displayed in a tabular
+// output, i.e. the exported ones not tagged with output:"-". It returns no
+// field if t is not a struct type.
+func outputFields(t reflect.Type) []int {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fields := make([]int, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+		if l, ok := t.Field(i).Tag.Lookup("output"); ok && l == "-" {
+			continue
+		}
+		fields = append(fields, i)
+	}
+
+	return fields
+}
+
+// outputColumns returns the indexes of the fields of t displayed as columns
+// of a tabular output: the ones selected by the user with the global
+// "--columns" flag in the requested order if any (including the fields not
+// displayed by default), all the displayed fields otherwise.
+func outputColumns(t reflect.Type) ([]int, error) {
+	if len(globalstate.Columns) == 0 {
+		return outputFields(t), nil
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("--columns is not supported by this command")
+	}
+
+	fields := make([]int, 0, len(globalstate.Columns))
+	for _, name := range globalstate.Columns {
+		i, ok := lookupOutputField(t, name)
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q, supported columns: %s",
+				name, strings.Join(outputColumnNames(t), ", "))
+		}
+		fields = append(fields, i)
+	}
+
+	return fields, nil
+}
+
+// outputColumnNames returns the names of the columns of t that can be
+// selected by the user, including the fields not displayed by default.
+func outputColumnNames(t reflect.Type) []string {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() {
+			names = append(names, outputFieldName(t.Field(i)))
+		}
+	}
+
+	return names
+}
+
+// outputFieldName returns the name identifying a field in the output, i.e. its
+// JSON attribute name, or its CamelCase name split with underscores if not
+// tagged.
+func outputFieldName(f reflect.StructField) string {
+	if l, ok := f.Tag.Lookup("json"); ok {
+		if name := strings.Split(l, ",")[0]; name != "" && name != "-" {
+			return name
+		}
+	}
+
+	return strings.ToLower(strings.Join(camelcase.Split(f.Name), "_"))
+}
+
+// outputFieldLabel returns the label of a field in a tabular output, which is
+// the field's CamelCase name split with spaces unless overridden with an
+// `outputLabel` tag.
+func outputFieldLabel(f reflect.StructField) string {
+	if l, ok := f.Tag.Lookup("outputLabel"); ok {
+		return l
+	}
+
+	return strings.Join(camelcase.Split(f.Name), " ")
+}
+
+// lookupOutputField returns the index of the field of t matching name, which
+// can be either its Go name, its JSON attribute name or its output label,
+// regardless of the case and of the word separators used.
+func lookupOutputField(t reflect.Type, name string) (int, bool) {
+	if t.Kind() != reflect.Struct {
+		return 0, false
+	}
+
+	name = normalizeColumnName(name)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		for _, candidate := range []string{f.Name, outputFieldName(f), outputFieldLabel(f)} {
+			if normalizeColumnName(candidate) == name {
+				return i, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func normalizeColumnName(name string) string {
+	return strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(name))
+}
+
+// ParseSortBy parses a "--sort-by" flag value in the form FIELD[:asc|desc].
+func ParseSortBy(s string) (field string, desc bool, err error) {
+	field, order, _ := strings.Cut(s, ":")
+	if field == "" {
+		return "", false, fmt.Errorf("invalid sort specification %q: missing field", s)
+	}
+
+	switch order {
+	case "", "asc":
+	case "desc":
+		desc = true
+	default:
+		return "", false, fmt.Errorf("invalid sort order %q, supported orders: asc, desc", order)
+	}
+
+	return field, desc, nil
+}
+
+// Sort sorts the items of o in place according to the global "--sort-by"
+// flag, if o is of iterable type (slice only) and the flag is set.
+func Sort(o interface{}) error {
+	if globalstate.SortBy == "" {
+		return nil
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(o))
+	if v.Kind() != reflect.Slice {
+		return nil
+	}
+
+	return sortSlice(v, globalstate.SortBy)
+}
+
+// sortSlice sorts the items of a slice of structs in place according to a
+// "--sort-by" specification. The sort is stable, so that items having the
+// same value keep their original order.
+func sortSlice(v reflect.Value, spec string) error {
+	t := v.Type().Elem()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	field, desc, err := sortField(t, spec)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]reflect.Value, v.Len())
+	for i := range keys {
+		// Nil pointer items have no sort key, and come first.
+		if item := reflect.Indirect(v.Index(i)); item.IsValid() {
+			keys[i] = item.Field(field)
+		}
+	}
+
+	// Sort a permutation of the items rather than the items themselves, as
+	// the sort keys have to be swapped along with them.
+	perm := make([]int, v.Len())
+	for i := range perm {
+		perm[i] = i
+	}
+	slices.SortStableFunc(perm, func(i, j int) int {
+		if desc {
+			return compareOutputValues(keys[j], keys[i])
+		}
+		return compareOutputValues(keys[i], keys[j])
+	})
+
+	sorted := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	for i, p := range perm {
+		sorted.Index(i).Set(v.Index(p))
+	}
+	reflect.Copy(v, sorted)
+
+	return nil
+}
+
+// sortField returns the index of the field of t to sort by according to a
+// "--sort-by" specification, and whether the sort order is descending.
+func sortField(t reflect.Type, spec string) (int, bool, error) {
+	name, desc, err := ParseSortBy(spec)
+	if err != nil {
+		return 0, false, err
+	}
+
+	field, ok := lookupOutputField(t, name)
+	if !ok {
+		return 0, false, fmt.Errorf("unable to sort by %q: unknown field, supported fields: %s",
+			name, strings.Join(outputColumnNames(t), ", "))
+	}
+
+	return field, desc, nil
+}
+
+// compareOutputValues compares two field values: numbers, booleans and times
+// are compared by value, nil pointers and invalid values come first, and
+// other values are compared using their string representation.
+func compareOutputValues(a, b reflect.Value) int {
+	switch {
+	case !a.IsValid() && !b.IsValid():
+		return 0
+	case !a.IsValid():
+		return -1
+	case !b.IsValid():
+		return 1
+	}
+
+	if a.Kind() == reflect.Pointer || a.Kind() == reflect.Interface {
+		switch {
+		case a.IsNil() && b.IsNil():
+			return 0
+		case a.IsNil():
+			return -1
+		case b.IsNil():
+			return 1
+		}
+		return compareOutputValues(a.Elem(), b.Elem())
+	}
+
+	if ta, ok := a.Interface().(time.Time); ok {
+		return ta.Compare(b.Interface().(time.Time))
+	}
+
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return cmp.Compare(a.Uint(), b.Uint())
+
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float())
+
+	case reflect.Bool:
+		return cmp.Compare(boolToInt(a.Bool()), boolToInt(b.Bool()))
+
+	case reflect.String:
+		return cmp.Compare(a.String(), b.String())
+
+	default:
+		return cmp.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+	}
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
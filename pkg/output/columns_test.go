@@ -0,0 +1,180 @@
+package output
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/exoscale/cli/pkg/globalstate"
+)
+
+func withColumns(t *testing.T, columns []string, sortBy string, noHeaders bool) func() {
+	t.Helper()
+	prevColumns, prevSortBy, prevNoHeaders := globalstate.Columns, globalstate.SortBy, globalstate.NoHeaders
+	globalstate.Columns, globalstate.SortBy, globalstate.NoHeaders = columns, sortBy, noHeaders
+	return func() {
+		globalstate.Columns, globalstate.SortBy, globalstate.NoHeaders = prevColumns, prevSortBy, prevNoHeaders
+	}
+}
+
+func TestOutputColumns(t *testing.T) {
+	typ := reflect.TypeOf(formatItem{})
+
+	for _, tt := range []struct {
+		columns []string
+		want    []string
+		err     string
+	}{
+		{columns: nil, want: []string{"ID", "Display Name", "IP", "Tags", "Labels", "Nested"}},
+		{columns: []string{"ip", "display_name"}, want: []string{"IP", "Display Name"}},
+		{columns: []string{"Display Name", "ID"}, want: []string{"Display Name", "ID"}},
+		{columns: []string{"name"}, want: []string{"Display Name"}},
+		{columns: []string{"name", "internal"}, want: []string{"Display Name", "Internal"}},
+		{columns: []string{"unknown"}, err: `unknown column "unknown", supported columns: id, name, ip, tags, labels, nested, internal`},
+	} {
+		t.Run(strings.Join(tt.columns, ","), func(t *testing.T) {
+			defer withColumns(t, tt.columns, "", false)()
+
+			fields, err := outputColumns(typ)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("want error %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("columns: %v", err)
+			}
+			if got := outputColumnsHeaders(typ, fields); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("want %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseSortBy(t *testing.T) {
+	for _, tt := range []struct {
+		spec  string
+		field string
+		desc  bool
+		err   bool
+	}{
+		{spec: "name", field: "name"},
+		{spec: "name:asc", field: "name"},
+		{spec: "name:desc", field: "name", desc: true},
+		{spec: "name:up", err: true},
+		{spec: ":desc", err: true},
+	} {
+		field, desc, err := ParseSortBy(tt.spec)
+		if tt.err {
+			if err == nil {
+				t.Errorf("%q: want error", tt.spec)
+			}
+			continue
+		}
+		if err != nil || field != tt.field || desc != tt.desc {
+			t.Errorf("%q: got (%q, %v, %v)", tt.spec, field, desc, err)
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	rows := func() []streamRow {
+		return []streamRow{
+			{Name: "b", Zone: "z1", N: 10},
+			{Name: "c", Zone: "z2", N: 2},
+			{Name: "a", Zone: "z1", N: 3},
+		}
+	}
+	names := func(rows []streamRow) string {
+		var s []string
+		for _, r := range rows {
+			s = append(s, r.Name)
+		}
+		return strings.Join(s, "")
+	}
+
+	for _, tt := range []struct {
+		spec string
+		want string
+	}{
+		{spec: "", want: "bca"},
+		{spec: "name", want: "abc"},
+		{spec: "name:desc", want: "cba"},
+		{spec: "n", want: "cab"},
+		{spec: "N:desc", want: "bac"},
+		{spec: "zone", want: "bac"},
+		{spec: "zone:desc", want: "cba"},
+	} {
+		t.Run(tt.spec, func(t *testing.T) {
+			defer withColumns(t, nil, tt.spec, false)()
+
+			items := rows()
+			if err := Sort(&items); err != nil {
+				t.Fatalf("sort: %v", err)
+			}
+			if got := names(items); got != tt.want {
+				t.Fatalf("want %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	defer withColumns(t, nil, "unknown", false)()
+	items := rows()
+	if err := Sort(&items); err == nil {
+		t.Fatal("want error for unknown field")
+	}
+}
+
+func TestSortNilItems(t *testing.T) {
+	defer withColumns(t, nil, "name", false)()
+
+	items := []*streamRow{{Name: "b"}, nil, {Name: "a"}}
+	if err := Sort(&items); err != nil {
+		t.Fatalf("sort: %v", err)
+	}
+	if items[0] != nil || items[1].Name != "a" || items[2].Name != "b" {
+		t.Fatalf("unexpected order: %v, %v, %v", items[0], items[1], items[2])
+	}
+}
+
+func TestStreamingColumns(t *testing.T) {
+	defer withFormat(t, "csv")()
+	defer withColumns(t, []string{"n", "name"}, "n:desc", true)()
+
+	var buf bytes.Buffer
+	s := NewStreamer(streamRow{}, &buf)
+	for _, r := range []streamRow{{Name: "a", N: 1}, {Name: "b", N: 2}} {
+		if err := s.Push(r); err != nil {
+			t.Fatalf("push: %v", err)
+		}
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	if want := "2,b\n1,a\n"; buf.String() != want {
+		t.Fatalf("want %q, got %q", want, buf.String())
+	}
+}
+
+func TestStreamingTableColumns(t *testing.T) {
+	defer withFormat(t, "")()
+	defer withColumns(t, []string{"zone"}, "", true)()
+
+	var buf bytes.Buffer
+	s := NewStreamer(streamRow{}, &buf)
+	if err := s.Push(streamRow{Name: "a", Zone: "z1", N: 1}); err != nil {
+		t.Fatalf("push: %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	// Expected: top border, row, bottom border.
+	want := "┼──────────────┼\n│ z1           │\n┼──────────────┼\n"
+	if buf.String() != want {
+		t.Fatalf("want:\n%s\ngot:\n%s", want, buf.String())
+	}
+}
@@ -0,0 +1,204 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/exoscale/cli/pkg/globalstate"
+)
+
+// YAMLOutputter is optionally implemented by Outputter types requiring a
+// specific YAML rendering, instead of the default one provided by YAML().
+type YAMLOutputter interface {
+	ToYAML()
+}
+
+// CSVOutputter is optionally implemented by Outputter types requiring a
+// specific CSV rendering, instead of the default one provided by CSV().
+type CSVOutputter interface {
+	ToCSV()
+}
+
+// YAML prints a YAML-formatted rendering of o to the terminal. The YAML
+// document has the same structure as the JSON rendering of o.
+func YAML(o interface{}) {
+	data, err := MarshalYAML(o)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: unable to encode output to YAML: %s\n", err)
+		os.Exit(1)
+	}
+
+	_, _ = os.Stdout.Write(data)
+}
+
+// MarshalYAML returns the YAML encoding of o, based on its JSON encoding so
+// that both renderings share the same attribute names and ordering.
+func MarshalYAML(o interface{}) ([]byte, error) {
+	data, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decoding into a yaml.MapSlice preserves the order of the attributes
+	// of nested objects. The value is wrapped into an object so that the
+	// order is also preserved for top-level lists.
+	var doc yaml.MapSlice
+	if err := yaml.Unmarshal([]byte(`{"v":`+string(data)+`}`), &doc); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(doc[0].Value)
+}
+
+// CSV prints a CSV-formatted rendering of o to the terminal. If the object
+// is of iterable type (slice only), each item is printed in a row following
+// a header row containing one column per type field, similar to Table().
+// Otherwise, the object is printed as a single row following the header row.
+// As with Table(), the columns printed and the header row can be controlled
+// using the global "--columns" and "--no-headers" flags.
+func CSV(o interface{}) {
+	if err := writeCSV(os.Stdout, o); err != nil {
+		fmt.Fprintf(os.Stderr, "error: unable to encode output to CSV: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func writeCSV(w io.Writer, o interface{}) error {
+	v := reflect.Indirect(reflect.ValueOf(o))
+	if !v.IsValid() {
+		return nil
+	}
+	t := v.Type()
+	if v.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("csv output format not supported for this command")
+	}
+
+	fields, err := outputColumns(t)
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if !globalstate.NoHeaders {
+		if err := cw.Write(outputColumnsHeaders(t, fields)); err != nil {
+			return err
+		}
+	}
+
+	if v.Kind() == reflect.Slice {
+		for i := 0; i < v.Len(); i++ {
+			item := reflect.Indirect(v.Index(i))
+			if !item.IsValid() {
+				// Nil pointer item.
+				item = reflect.Zero(t)
+			}
+			if err := cw.Write(outputCSVRecord(item, fields)); err != nil {
+				return err
+			}
+		}
+	} else if err := cw.Write(outputCSVRecord(v, fields)); err != nil {
+		return err
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// outputCSVRecord turns the given fields of an item into a CSV record.
+func outputCSVRecord(item reflect.Value, fields []int) []string {
+	record := make([]string, 0, len(fields))
+	for _, i := range fields {
+		record = append(record, outputCSVValue(item.Field(i)))
+	}
+
+	return record
+}
+
+// outputCSVValue formats a field value as a CSV cell: scalar values and
+// types implementing fmt.Stringer are printed as-is, lists of scalar values
+// are comma-separated, string maps are printed as comma-separated
+// KEY=VALUE pairs, and other composite values are JSON-encoded.
+func outputCSVValue(v reflect.Value) string {
+	if !v.IsValid() || !v.CanInterface() {
+		return ""
+	}
+
+	if v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		if s, ok := v.Interface().(fmt.Stringer); ok {
+			return s.String()
+		}
+		return outputCSVValue(v.Elem())
+	}
+
+	if (v.Kind() == reflect.Slice || v.Kind() == reflect.Map) && v.Len() == 0 {
+		return ""
+	}
+
+	if v.CanInterface() {
+		if s, ok := v.Interface().(fmt.Stringer); ok {
+			return s.String()
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if isCSVScalar(v.Type().Elem()) {
+			items := make([]string, 0, v.Len())
+			for i := 0; i < v.Len(); i++ {
+				items = append(items, fmt.Sprint(v.Index(i).Interface()))
+			}
+			return strings.Join(items, ",")
+		}
+
+	case reflect.Map:
+		if v.Type().Key().Kind() == reflect.String && isCSVScalar(v.Type().Elem()) {
+			items := make([]string, 0, v.Len())
+			for _, k := range v.MapKeys() {
+				items = append(items, fmt.Sprintf("%s=%v", k.Interface(), v.MapIndex(k).Interface()))
+			}
+			sort.Strings(items)
+			return strings.Join(items, ",")
+		}
+
+	case reflect.Struct:
+
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return fmt.Sprint(v.Interface())
+	}
+	return string(data)
+}
+
+func isCSVScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64,
+		reflect.String:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,112 @@
+package output
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+type formatItem struct {
+	ID       v3.UUID           `json:"id"`
+	Name     string            `json:"name" outputLabel:"Display Name"`
+	IP       net.IP            `json:"ip"`
+	Tags     []string          `json:"tags"`
+	Labels   map[string]string `json:"labels"`
+	Nested   *formatNested     `json:"nested"`
+	Internal string            `json:"internal" output:"-"`
+}
+
+type formatNested struct {
+	B int    `json:"b"`
+	A string `json:"a"`
+}
+
+func TestMarshalYAML(t *testing.T) {
+	out, err := MarshalYAML([]formatItem{{
+		ID:     "11111111-1111-1111-1111-111111111111",
+		Name:   "a",
+		Tags:   []string{"x", "y"},
+		Nested: &formatNested{B: 1, A: "z"},
+	}})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	want := `- id: 11111111-1111-1111-1111-111111111111
+  name: a
+  ip: ""
+  tags:
+  - x
+  - "y"
+  labels: null
+  nested:
+    b: 1
+    a: z
+  internal: ""
+`
+	if string(out) != want {
+		t.Fatalf("want:\n%s\ngot:\n%s", want, out)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	items := []formatItem{
+		{
+			ID:       "11111111-1111-1111-1111-111111111111",
+			Name:     "a",
+			IP:       net.ParseIP("192.0.2.1"),
+			Tags:     []string{"x", "y"},
+			Labels:   map[string]string{"k2": "v2", "k1": "v1"},
+			Nested:   &formatNested{B: 1, A: "z"},
+			Internal: "hidden",
+		},
+		{Name: "b"},
+	}
+
+	var buf bytes.Buffer
+	if err := writeCSV(&buf, &items); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	want := `ID,Display Name,IP,Tags,Labels,Nested
+11111111-1111-1111-1111-111111111111,a,192.0.2.1,"x,y","k1=v1,k2=v2","{""b"":1,""a"":""z""}"
+,b,,,,
+`
+	if buf.String() != want {
+		t.Fatalf("want:\n%s\ngot:\n%s", want, buf.String())
+	}
+
+	buf.Reset()
+	if err := writeCSV(&buf, &items[1]); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if want := "ID,Display Name,IP,Tags,Labels,Nested\n,b,,,,\n"; buf.String() != want {
+		t.Fatalf("want %q, got %q", want, buf.String())
+	}
+}
+
+func TestWriteCSVUnsupported(t *testing.T) {
+	type item struct {
+		Name     string
+		internal string
+	}
+
+	var buf bytes.Buffer
+	if err := writeCSV(&buf, &[]*item{{Name: "a", internal: "x"}, nil}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if want := "Name\na\n\n"; buf.String() != want {
+		t.Fatalf("want %q, got %q", want, buf.String())
+	}
+
+	for _, o := range []interface{}{
+		&map[string]string{"k": "v"},
+		&[]string{"a", "b"},
+	} {
+		if err := writeCSV(&buf, o); err == nil || err.Error() != "csv output format not supported for this command" {
+			t.Errorf("%T: want unsupported error, got %v", o, err)
+		}
+	}
+}
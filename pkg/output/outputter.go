@@ -9,10 +9,9 @@ import (
 	"strings"
 	"text/template"
 
+	"github.com/exoscale/cli/pkg/globalstate"
 	"github.com/exoscale/cli/table"
 	v3 "github.com/exoscale/egoscale/v3"
-
-	"github.com/fatih/camelcase"
 )
 
 var (
@@ -34,7 +33,8 @@ func JSON(o interface{}) {
 // objects. In addition to the methods, types implementing this interface can
 // also use struct tags to modify the output logic:
 //   - output:"-" is similar to package encoding/json, i.e. that a field with
-//     this tag will not be displayed
+//     this tag will not be displayed, unless explicitly selected with the
+//     global "--columns" flag
 //   - outputLabel:"..." overrides the string displayed as label, which by
 //     default is the field's CamelCase named split with spaces
 type Outputter interface {
@@ -51,11 +51,11 @@ func Text(o interface{}) {
 	tpl := GOutputTemplate
 
 	if tpl == "" {
-		tplFields := TemplateAnnotations(o)
-		for i := range tplFields {
-			tplFields[i] = "{{" + tplFields[i] + "}}"
+		var err error
+		if tpl, err = defaultTextTemplate(o); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err)
+			os.Exit(1)
 		}
-		tpl = strings.Join(tplFields, "\t")
 	}
 
 	t, err := template.New("out").Parse(tpl)
@@ -83,23 +83,46 @@ func Text(o interface{}) {
 	}
 }
 
+// defaultTextTemplate returns the template printing the fields of o, or the
+// ones selected using the global "--columns" flag, separated by a tabulation
+// character.
+func defaultTextTemplate(o interface{}) (string, error) {
+	if len(globalstate.Columns) == 0 {
+		tplFields := TemplateAnnotations(o)
+		for i := range tplFields {
+			tplFields[i] = "{{" + tplFields[i] + "}}"
+		}
+		return strings.Join(tplFields, "\t"), nil
+	}
+
+	t := reflect.Indirect(reflect.ValueOf(o)).Type()
+	if t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+
+	fields, err := outputColumns(t)
+	if err != nil {
+		return "", err
+	}
+
+	tplFields := make([]string, 0, len(fields))
+	for _, i := range fields {
+		tplFields = append(tplFields, "{{."+t.Field(i).Name+"}}")
+	}
+	return strings.Join(tplFields, "\t"), nil
+}
+
 // outputTableHeaders turns CamelCase field names into eye-friendlier labels.
 // If the field has an `outputLabel` tag, use its value to override the header label.
 func outputTableHeaders(t reflect.Type) []string {
-	headers := make([]string, 0, t.NumField())
-	for i := 0; i < t.NumField(); i++ {
-		// Check if the field has to be skipped.
-		if l, ok := t.Field(i).Tag.Lookup("output"); ok {
-			if l == "-" {
-				continue
-			}
-		}
+	return outputColumnsHeaders(t, outputFields(t))
+}
 
-		label := strings.Join(camelcase.Split(t.Field(i).Name), " ")
-		if l, ok := t.Field(i).Tag.Lookup("outputLabel"); ok {
-			label = l
-		}
-		headers = append(headers, label)
+// outputColumnsHeaders returns the labels of the given fields of t.
+func outputColumnsHeaders(t reflect.Type, fields []int) []string {
+	headers := make([]string, 0, len(fields))
+	for _, i := range fields {
+		headers = append(headers, outputFieldLabel(t.Field(i)))
 	}
 
 	return headers
@@ -107,15 +130,14 @@ func outputTableHeaders(t reflect.Type) []string {
 
 // outputTableRow turns the fields of an item into a table row
 func outputTableRow(item reflect.Value) []string {
-	row := []string{}
-	for i := 0; i < item.NumField(); i++ {
+	return outputColumnsRow(item, outputFields(item.Type()))
+}
+
+// outputColumnsRow turns the given fields of an item into a table row
+func outputColumnsRow(item reflect.Value, fields []int) []string {
+	row := make([]string, 0, len(fields))
+	for _, i := range fields {
 		field := item.Field(i)
-		// Check if the field has to be skipped.
-		if l, ok := item.Type().Field(i).Tag.Lookup("output"); ok {
-			if l == "-" {
-				continue
-			}
-		}
 
 		switch field.Kind() {
 		case reflect.Slice:
@@ -130,9 +152,13 @@ func outputTableRow(item reflect.Value) []string {
 		case reflect.Map:
 			// If the field value is a map and is empty,
 			// print "n/a" instead of an empty map.
-			if field.Len() == 0 {
+			// String maps (e.g. labels) are printed as KEY=VALUE pairs.
+			switch {
+			case field.Len() == 0:
 				row = append(row, "n/a")
-			} else {
+			case field.Type().Elem().Kind() == reflect.String:
+				row = append(row, outputCSVValue(field))
+			default:
 				row = append(row, fmt.Sprint(field.Interface()))
 			}
 
@@ -157,7 +183,8 @@ func outputTableRow(item reflect.Value) []string {
 // table row, with a header containing one column per type field. Otherwise,
 // each field of the object is printed in a key/value formatted table, and a
 // header is printed if the item type implements an optional (Type() string)
-// method.
+// method. The columns (or rows) printed and the header can be controlled using
+// the global "--columns" and "--no-headers" flags.
 func Table(o interface{}) {
 	tab := table.NewTable(os.Stdout)
 
@@ -170,15 +197,21 @@ func Table(o interface{}) {
 		t = v.Type().Elem()
 	}
 
-	headers := outputTableHeaders(t)
+	fields, err := outputColumns(t)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
 
 	// If the outputter interface is iterable (slice only), we loop over the
 	// items and display each one in a table row.
 	if v := reflect.ValueOf(o); reflect.Indirect(v).Kind() == reflect.Slice {
-		tab.SetHeader(headers)
+		if !globalstate.NoHeaders {
+			tab.SetHeader(outputColumnsHeaders(t, fields))
+		}
 
 		for i := 0; i < reflect.Indirect(v).Len(); i++ {
-			row := outputTableRow(reflect.Indirect(v).Index(i))
+			row := outputColumnsRow(reflect.Indirect(v).Index(i), fields)
 			tab.Append(row)
 		}
 
@@ -190,24 +223,15 @@ func Table(o interface{}) {
 
 	// If the outputter interface implements the optional `Type()` method,
 	// use its return value as table header.
-	if typeMethod := reflect.ValueOf(o).MethodByName("Type"); typeMethod.Kind() != reflect.Invalid {
+	typeMethod := reflect.ValueOf(o).MethodByName("Type")
+	if typeMethod.Kind() != reflect.Invalid && !globalstate.NoHeaders {
 		in := make([]reflect.Value, typeMethod.Type().NumIn())
 		header := typeMethod.Call(in)[0].Interface().(string)
 		tab.SetHeader([]string{header, ""})
 	}
 
-	for i := 0; i < t.NumField(); i++ {
-		// Check if the field has to be skipped.
-		if l, ok := t.Field(i).Tag.Lookup("output"); ok {
-			if l == "-" {
-				continue
-			}
-		}
-
-		label := strings.Join(camelcase.Split(t.Field(i).Name), " ")
-		if l, ok := t.Field(i).Tag.Lookup("outputLabel"); ok {
-			label = l
-		}
+	for _, i := range fields {
+		label := outputFieldLabel(t.Field(i))
 
 		switch v.Field(i).Kind() {
 		case reflect.Slice:
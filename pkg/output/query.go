@@ -0,0 +1,353 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	jmespath "github.com/danielgtaylor/go-jmespath-plus"
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/table"
+)
+
+// CompileQuery parses a JMESPath query expression.
+func CompileQuery(expr string) (*jmespath.JMESPath, error) {
+	q, err := jmespath.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", expr, err)
+	}
+
+	return q, nil
+}
+
+// Query evaluates a JMESPath expression against the JSON form of o, and
+// returns the result as generic JSON values.
+func Query(o interface{}, expr string) (interface{}, error) {
+	res, _, err := query(o, expr)
+	return res, err
+}
+
+// query evaluates a JMESPath expression against the JSON form of o, and
+// returns the result along with the rank of the object keys of o.
+func query(o interface{}, expr string) (interface{}, map[string]int, error) {
+	q, err := CompileQuery(expr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := json.Marshal(o)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, nil, err
+	}
+
+	res, err := q.Search(v)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to evaluate query %q: %w", expr, err)
+	}
+
+	order, err := jsonKeysOrder(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, order, nil
+}
+
+// jsonKeysOrder returns the rank of the object keys of a JSON document, in
+// order of first appearance.
+func jsonKeysOrder(data []byte) (map[string]int, error) {
+	order := map[string]int{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	var walk func() error
+	walk = func() error {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch tok {
+		case json.Delim('{'):
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				if _, ok := order[key.(string)]; !ok {
+					order[key.(string)] = len(order)
+				}
+				if err := walk(); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		case json.Delim('['):
+			for dec.More() {
+				if err := walk(); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		}
+		return err
+	}
+
+	return order, walk()
+}
+
+// PrintQuery evaluates a JMESPath expression against the JSON form of o,
+// and prints the result to w according to the active output format.
+func PrintQuery(w io.Writer, o interface{}, expr string) error {
+	res, order, err := query(o, expr)
+	if err != nil {
+		return err
+	}
+
+	p := queryPrinter{w: w, order: order}
+	return p.print(res)
+}
+
+// queryPrinter prints the generic result of a query. Since the result has no
+// Go type, the "table", "csv" and "text" formats order the object attributes
+// as in the original output, the others being sorted alphabetically.
+type queryPrinter struct {
+	w     io.Writer
+	order map[string]int
+}
+
+func (p *queryPrinter) print(v interface{}) error {
+	w := p.w
+	if GOutputTemplate != "" {
+		return p.printTemplate(v, GOutputTemplate)
+	}
+
+	switch globalstate.OutputFormat {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		return enc.Encode(v)
+
+	case "yaml":
+		data, err := MarshalYAML(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+
+	case "text":
+		return p.printText(v)
+
+	case "csv":
+		return p.printCSV(v)
+
+	default:
+		return p.printTable(v)
+	}
+}
+
+func (p *queryPrinter) printTemplate(v interface{}, tpl string) error {
+	t, err := template.New("out").Parse(tpl)
+	if err != nil {
+		return fmt.Errorf("unable to parse output template: %w", err)
+	}
+
+	items, ok := v.([]interface{})
+	if !ok {
+		items = []interface{}{v}
+	}
+	for _, item := range items {
+		if err := t.Execute(p.w, item); err != nil {
+			return fmt.Errorf("unable to encode output using template: %w", err)
+		}
+		if _, err := fmt.Fprintln(p.w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// printText prints each item of a list result on its own line, the
+// values of objects being separated by a tabulation character.
+func (p *queryPrinter) printText(v interface{}) error {
+	items, ok := v.([]interface{})
+	if !ok {
+		items = []interface{}{v}
+	}
+
+	for _, item := range items {
+		var line string
+		if obj, ok := item.(map[string]interface{}); ok {
+			keys := p.keys([]interface{}{obj})
+			values := make([]string, 0, len(keys))
+			for _, k := range keys {
+				values = append(values, queryValueString(obj[k]))
+			}
+			line = strings.Join(values, "\t")
+		} else {
+			line = queryValueString(item)
+		}
+		if _, err := fmt.Fprintln(p.w, line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rows returns the header and rows of a tabular rendering of a query
+// result, or an empty header if the result only contains scalar values.
+func (p *queryPrinter) rows(v interface{}) ([]string, [][]string) {
+	items, isList := v.([]interface{})
+	if !isList {
+		items = []interface{}{v}
+	}
+
+	keys := p.keys(items)
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if len(keys) == 0 || !ok {
+			rows = append(rows, []string{queryValueString(item)})
+			continue
+		}
+
+		row := make([]string, 0, len(keys))
+		for _, k := range keys {
+			row = append(row, queryValueString(obj[k]))
+		}
+		rows = append(rows, row)
+	}
+
+	return keys, rows
+}
+
+func (p *queryPrinter) printCSV(v interface{}) error {
+	cw := csv.NewWriter(p.w)
+
+	header, rows := p.rows(v)
+	if len(header) > 0 && !globalstate.NoHeaders {
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
+
+func (p *queryPrinter) printTable(v interface{}) error {
+	if v == nil {
+		return nil
+	}
+
+	// Objects are rendered as a key/value table, and scalar values as-is.
+	switch obj := v.(type) {
+	case map[string]interface{}:
+		t := newQueryTable(p.w)
+		for _, k := range p.keys([]interface{}{obj}) {
+			t.Append([]string{k, queryValueString(obj[k])})
+		}
+		t.Render()
+		return nil
+
+	case []interface{}:
+
+	default:
+		_, err := fmt.Fprintln(p.w, queryValueString(v))
+		return err
+	}
+
+	header, rows := p.rows(v)
+	if len(header) == 0 {
+		return p.printText(v)
+	}
+
+	t := newQueryTable(p.w)
+	if !globalstate.NoHeaders {
+		t.SetHeader(header)
+	}
+	t.AppendBulk(rows)
+	t.Render()
+
+	return nil
+}
+
+func newQueryTable(w io.Writer) *table.Table {
+	if f, ok := w.(*os.File); ok {
+		return table.NewTable(f)
+	}
+
+	return &table.Table{Table: tablewriter.NewWriter(w)}
+}
+
+// keys returns the union of the keys of the objects of a list, ordered as
+// in the original output, followed by the unknown ones (e.g. resulting
+// from a multi-select hash) sorted alphabetically.
+func (p *queryPrinter) keys(items []interface{}) []string {
+	set := map[string]struct{}{}
+	for _, item := range items {
+		if obj, ok := item.(map[string]interface{}); ok {
+			for k := range obj {
+				set[k] = struct{}{}
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ri, iok := p.order[keys[i]]
+		rj, jok := p.order[keys[j]]
+		switch {
+		case iok && jok:
+			return ri < rj
+		case iok != jok:
+			return iok
+		default:
+			return keys[i] < keys[j]
+		}
+	})
+
+	return keys
+}
+
+// queryValueString formats a generic JSON value: scalar values are printed
+// as-is, and composite values are JSON-encoded.
+func queryValueString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
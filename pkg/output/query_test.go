@@ -0,0 +1,79 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+var queryTestRows = []streamRow{
+	{Name: "a", Zone: "z1", N: 1},
+	{Name: "b", Zone: "z2", N: 2},
+	{Name: "c", Zone: "z1", N: 3},
+}
+
+func TestPrintQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		query     string
+		noHeaders bool
+		want      string
+	}{
+		{"text list", "text", "[?zone=='z1'].name", false, "a\nc\n"},
+		{"text objects", "text", "[?n > `1`].{name: name, n: n}", false, "b\t2\nc\t3\n"},
+		{"json", "json", "[?zone=='z2']", false, `[{"n":2,"name":"b","zone":"z2"}]` + "\n"},
+		{"yaml", "yaml", "[0].{name: name, n: n}", false, "\"n\": 1\nname: a\n"},
+		{"csv", "csv", "[?zone=='z1'].{n: n, name: name}", false, "name,n\na,1\nc,3\n"},
+		{"csv no headers", "csv", "[?zone=='z1'].{n: n, name: name}", true, "a,1\nc,3\n"},
+		{"csv scalars", "csv", "[].name", false, "a\nb\nc\n"},
+		{"table scalar", "table", "length(@)", false, "3\n"},
+		{"null", "text", "[?zone=='z3'] | [0]", false, "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer withFormat(t, tt.format)()
+			defer withColumns(t, nil, "", tt.noHeaders)()
+			var buf bytes.Buffer
+			if err := PrintQuery(&buf, queryTestRows, tt.query); err != nil {
+				t.Fatalf("query: %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Fatalf("want %q, got %q", tt.want, buf.String())
+			}
+		})
+	}
+}
+
+func TestPrintQueryTemplate(t *testing.T) {
+	defer withFormat(t, "text")()
+	GOutputTemplate = "{{.name}}@{{.zone}}"
+	var buf bytes.Buffer
+	if err := PrintQuery(&buf, queryTestRows, "[?n < `3`]"); err != nil {
+		t.Fatalf("query: %v", err)
+	}
+	if want := "a@z1\nb@z2\n"; buf.String() != want {
+		t.Fatalf("want %q, got %q", want, buf.String())
+	}
+}
+
+func TestPrintQueryInvalid(t *testing.T) {
+	var buf bytes.Buffer
+	if err := PrintQuery(&buf, queryTestRows, "[?"); err == nil {
+		t.Fatal("want invalid query error")
+	}
+}
+
+func TestPrintQueryTableNoHeaders(t *testing.T) {
+	defer withFormat(t, "table")()
+	defer withColumns(t, nil, "", true)()
+
+	var buf bytes.Buffer
+	if err := PrintQuery(&buf, queryTestRows, "[?zone=='z1'].{name: name, n: n}"); err != nil {
+		t.Fatalf("query: %v", err)
+	}
+	if out := buf.String(); strings.Contains(strings.ToUpper(out), "NAME") || !strings.Contains(out, "a") {
+		t.Fatalf("unexpected table:\n%s", out)
+	}
+}
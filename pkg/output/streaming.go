@@ -1,9 +1,11 @@
 package output
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
 	"reflect"
 	"strconv"
 	"strings"
@@ -26,12 +28,27 @@ type StreamingOutputter interface {
 // used to derive table headers and the JSON envelope element type.
 // w is where the streamer writes (typically os.Stdout).
 func NewStreamer(rowType any, w io.Writer) StreamingOutputter {
+	s := newStreamer(rowType, w)
+	if globalstate.SortBy != "" {
+		return newSortingStreamer(rowType, s, globalstate.SortBy)
+	}
+	return s
+}
+
+func newStreamer(rowType any, w io.Writer) StreamingOutputter {
+	if globalstate.Query != "" {
+		return newQueryStreamer(rowType, w, globalstate.Query)
+	}
 	if GOutputTemplate != "" {
 		return newTextStreamer(rowType, w, GOutputTemplate)
 	}
 	switch globalstate.OutputFormat {
 	case "json":
 		return newJSONStreamer(rowType, w)
+	case "yaml":
+		return newYAMLStreamer(rowType, w)
+	case "csv":
+		return newCSVStreamer(rowType, w)
 	case "text":
 		return newTextStreamer(rowType, w, "")
 	default:
@@ -39,6 +56,18 @@ func NewStreamer(rowType any, w io.Writer) StreamingOutputter {
 	}
 }
 
+// rowColumns returns the fields of t displayed as columns of a tabular
+// output. As the streamers cannot report an error before rows are pushed, an
+// invalid "--columns" flag is a fatal error.
+func rowColumns(t reflect.Type) []int {
+	fields, err := outputColumns(t)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+	return fields
+}
+
 // rowKindOf returns the underlying struct type of rowType.
 func rowKindOf(rowType any) reflect.Type {
 	t := reflect.TypeOf(rowType)
@@ -60,11 +89,11 @@ func newTextStreamer(rowType any, w io.Writer, userTpl string) *textStreamer {
 	tpl := userTpl
 	if tpl == "" {
 		zero := reflect.New(rowKindOf(rowType)).Elem().Interface()
-		fields := TemplateAnnotations(zero)
-		for i := range fields {
-			fields[i] = "{{" + fields[i] + "}}"
+		var err error
+		if tpl, err = defaultTextTemplate(zero); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err)
+			os.Exit(1)
 		}
-		tpl = strings.Join(fields, "\t")
 	}
 	t, err := template.New("out").Parse(tpl)
 	if err != nil {
@@ -98,6 +127,7 @@ const defaultTableMinWidth = 12
 type tableStreamer struct {
 	mu      sync.Mutex
 	w       io.Writer
+	fields  []int
 	headers []string
 	widths  []int // content widths; cell visual width is widths[i]+2
 	started bool
@@ -106,30 +136,26 @@ type tableStreamer struct {
 
 func newTableStreamer(rowType any, w io.Writer) *tableStreamer {
 	t := rowKindOf(rowType)
-	headers := outputTableHeaders(t)
-	widths := tableColumnWidths(t, headers)
+	fields := rowColumns(t)
+	headers := outputColumnsHeaders(t, fields)
+	widths := tableColumnWidths(t, fields, headers)
 	// Match tablewriter's auto-format: uppercase headers.
 	for i := range headers {
 		headers[i] = strings.ToUpper(headers[i])
 	}
-	return &tableStreamer{w: w, headers: headers, widths: widths}
+	return &tableStreamer{w: w, fields: fields, headers: headers, widths: widths}
 }
 
 // tableColumnWidths derives a content width per column from the
 // outputWidth struct tag, falling back to max(header length,
 // defaultTableMinWidth).
-func tableColumnWidths(t reflect.Type, headers []string) []int {
+func tableColumnWidths(t reflect.Type, fields []int, headers []string) []int {
 	widths := make([]int, len(headers))
-	col := 0
-	for i := 0; i < t.NumField(); i++ {
+	for col, i := range fields {
 		f := t.Field(i)
-		if l, ok := f.Tag.Lookup("output"); ok && l == "-" {
-			continue
-		}
 		if w, ok := f.Tag.Lookup("outputWidth"); ok {
 			if n, err := strconv.Atoi(w); err == nil && n > 0 {
 				widths[col] = n
-				col++
 				continue
 			}
 		}
@@ -139,7 +165,6 @@ func tableColumnWidths(t reflect.Type, headers []string) []int {
 		} else {
 			widths[col] = defaultTableMinWidth
 		}
-		col++
 	}
 	return widths
 }
@@ -149,11 +174,13 @@ func (s *tableStreamer) Push(row any) error {
 	defer s.mu.Unlock()
 	if !s.started {
 		s.writeBorder()
-		s.writeRow(s.headers)
-		s.writeBorder()
+		if !globalstate.NoHeaders {
+			s.writeRow(s.headers)
+			s.writeBorder()
+		}
 		s.started = true
 	}
-	cells := outputTableRow(reflect.Indirect(reflect.ValueOf(row)))
+	cells := outputColumnsRow(reflect.Indirect(reflect.ValueOf(row)), s.fields)
 	s.writeRow(cells)
 	return nil
 }
@@ -263,3 +290,167 @@ func (s *jsonStreamer) Close() error {
 	enc.SetEscapeHTML(false)
 	return enc.Encode(s.rows.Interface())
 }
+
+// --- yaml ---
+
+// yamlStreamer emits each row as an item of a YAML list as soon as it is
+// pushed.
+type yamlStreamer struct {
+	mu      sync.Mutex
+	w       io.Writer
+	rowType reflect.Type
+	started bool
+	closed  bool
+}
+
+func newYAMLStreamer(rowType any, w io.Writer) *yamlStreamer {
+	return &yamlStreamer{w: w, rowType: rowKindOf(rowType)}
+}
+
+func (s *yamlStreamer) Push(row any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v := reflect.Indirect(reflect.ValueOf(row))
+	if v.Type() != s.rowType {
+		return fmt.Errorf("yaml stream: row type mismatch: got %s want %s", v.Type(), s.rowType)
+	}
+	data, err := MarshalYAML([]any{v.Interface()})
+	if err != nil {
+		return fmt.Errorf("yaml stream: %w", err)
+	}
+	s.started = true
+	_, err = s.w.Write(data)
+	return err
+}
+
+func (s *yamlStreamer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	if !s.started {
+		_, err := fmt.Fprintln(s.w, "[]")
+		return err
+	}
+	return nil
+}
+
+// --- csv ---
+
+// csvStreamer emits a header row followed by one record per row pushed. The
+// header row is emitted even if no row is pushed, unless disabled with the
+// global "--no-headers" flag.
+type csvStreamer struct {
+	mu      sync.Mutex
+	w       *csv.Writer
+	fields  []int
+	headers []string
+	started bool
+	closed  bool
+}
+
+func newCSVStreamer(rowType any, w io.Writer) *csvStreamer {
+	t := rowKindOf(rowType)
+	fields := rowColumns(t)
+	return &csvStreamer{w: csv.NewWriter(w), fields: fields, headers: outputColumnsHeaders(t, fields)}
+}
+
+func (s *csvStreamer) writeHeaders() error {
+	if s.started {
+		return nil
+	}
+	s.started = true
+	if globalstate.NoHeaders {
+		return nil
+	}
+	return s.w.Write(s.headers)
+}
+
+func (s *csvStreamer) Push(row any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.writeHeaders(); err != nil {
+		return fmt.Errorf("csv stream: %w", err)
+	}
+	if err := s.w.Write(outputCSVRecord(reflect.Indirect(reflect.ValueOf(row)), s.fields)); err != nil {
+		return fmt.Errorf("csv stream: %w", err)
+	}
+	s.w.Flush()
+	return s.w.Error()
+}
+
+func (s *csvStreamer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	if err := s.writeHeaders(); err != nil {
+		return fmt.Errorf("csv stream: %w", err)
+	}
+	s.w.Flush()
+	return s.w.Error()
+}
+
+// --- query ---
+
+// queryStreamer buffers the rows pushed, and prints the result of a query
+// evaluated against the complete list of rows once closed.
+type queryStreamer struct {
+	*jsonStreamer
+	query string
+}
+
+func newQueryStreamer(rowType any, w io.Writer, query string) *queryStreamer {
+	return &queryStreamer{jsonStreamer: newJSONStreamer(rowType, w), query: query}
+}
+
+func (s *queryStreamer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	return PrintQuery(s.w, s.rows.Interface(), s.query)
+}
+
+// --- sorting ---
+
+// sortingStreamer buffers the rows pushed, and pushes them sorted to the
+// underlying streamer once closed.
+type sortingStreamer struct {
+	*jsonStreamer
+	next StreamingOutputter
+	spec string
+}
+
+func newSortingStreamer(rowType any, next StreamingOutputter, spec string) *sortingStreamer {
+	if _, _, err := sortField(rowKindOf(rowType), spec); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+	return &sortingStreamer{jsonStreamer: newJSONStreamer(rowType, nil), next: next, spec: spec}
+}
+
+func (s *sortingStreamer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	if err := sortSlice(s.rows, s.spec); err != nil {
+		return err
+	}
+	for i := 0; i < s.rows.Len(); i++ {
+		if err := s.next.Push(s.rows.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return s.next.Close()
+}
@@ -102,6 +102,74 @@ func TestStreamingText(t *testing.T) {
 	}
 }
 
+func TestStreamingYAML(t *testing.T) {
+	defer withFormat(t, "yaml")()
+	var buf bytes.Buffer
+	s := NewStreamer(streamRow{}, &buf)
+
+	if err := s.Push(streamRow{Name: "a", Zone: "z1", N: 1}); err != nil {
+		t.Fatalf("push: %v", err)
+	}
+	if err := s.Push(streamRow{Name: "b", Zone: "z2", N: 2}); err != nil {
+		t.Fatalf("push: %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	want := "- name: a\n  zone: z1\n  \"n\": 1\n- name: b\n  zone: z2\n  \"n\": 2\n"
+	if buf.String() != want {
+		t.Fatalf("want %q, got %q", want, buf.String())
+	}
+}
+
+func TestStreamingYAMLEmpty(t *testing.T) {
+	defer withFormat(t, "yaml")()
+	var buf bytes.Buffer
+	s := NewStreamer(streamRow{}, &buf)
+	if err := s.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if got := strings.TrimSpace(buf.String()); got != "[]" {
+		t.Fatalf("empty stream: want '[]', got %q", got)
+	}
+}
+
+func TestStreamingCSV(t *testing.T) {
+	defer withFormat(t, "csv")()
+	type row struct {
+		Name   string
+		Zone   string `outputLabel:"Zone Name"`
+		Secret string `output:"-"`
+	}
+	var buf bytes.Buffer
+	s := NewStreamer(row{}, &buf)
+
+	if err := s.Push(row{Name: "a,b", Zone: "z1", Secret: "x"}); err != nil {
+		t.Fatalf("push: %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	want := "Name,Zone Name\n\"a,b\",z1\n"
+	if buf.String() != want {
+		t.Fatalf("want %q, got %q", want, buf.String())
+	}
+}
+
+func TestStreamingCSVEmpty(t *testing.T) {
+	defer withFormat(t, "csv")()
+	var buf bytes.Buffer
+	s := NewStreamer(streamRow{}, &buf)
+	if err := s.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if got := buf.String(); got != "Name,Zone,N\n" {
+		t.Fatalf("empty stream: want headers only, got %q", got)
+	}
+}
+
 func TestStreamingTable(t *testing.T) {
 	defer withFormat(t, "")() // default = table
 	var buf bytes.Buffer
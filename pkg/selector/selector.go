@@ -0,0 +1,199 @@
+// Package selector implements Kubernetes-style label selectors, used to
+// filter resources according to their labels.
+//
+// A selector is a comma-separated list of requirements, all of which must be
+// satisfied for a set of labels to match:
+//   - key=value, key==value: the label is set to value
+//   - key!=value: the label is not set to value, or not set
+//   - key in (v1,v2): the label is set to one of the values listed
+//   - key notin (v1,v2): the label is not set to any of the values listed, or
+//     not set
+//   - key: the label is set
+//   - !key: the label is not set
+package selector
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/exoscale/cli/pkg/collections"
+)
+
+type operator int
+
+const (
+	opEquals operator = iota
+	opNotEquals
+	opIn
+	opNotIn
+	opExists
+	opDoesNotExist
+)
+
+type requirement struct {
+	key    string
+	op     operator
+	values collections.Set[string]
+}
+
+// Selector is a parsed label selector. The zero value matches any set of
+// labels.
+type Selector struct {
+	requirements []requirement
+}
+
+var (
+	setRequirementRe = regexp.MustCompile(`^([^\s=!(),]+)\s+(in|notin)\s*\((.*)\)$`)
+	keyRe            = regexp.MustCompile(`^[^\s=!(),]+$`)
+)
+
+// Parse parses a label selector expression. An empty expression returns a
+// selector matching any set of labels.
+func Parse(s string) (Selector, error) {
+	var sel Selector
+
+	terms, err := splitTerms(s)
+	if err != nil {
+		return Selector{}, err
+	}
+
+	for _, term := range terms {
+		req, err := parseRequirement(term)
+		if err != nil {
+			return Selector{}, fmt.Errorf("invalid label selector %q: %w", s, err)
+		}
+		sel.requirements = append(sel.requirements, req)
+	}
+
+	return sel, nil
+}
+
+// splitTerms splits a selector expression on the commas separating its
+// requirements, i.e. excluding those separating the values of a set.
+func splitTerms(s string) ([]string, error) {
+	terms := make([]string, 0)
+	depth, start := 0, 0
+
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, s[start:i])
+				start = i + 1
+			}
+		}
+		if depth < 0 || depth > 1 {
+			return nil, fmt.Errorf("invalid label selector %q: unbalanced parentheses", s)
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("invalid label selector %q: unbalanced parentheses", s)
+	}
+	terms = append(terms, s[start:])
+
+	// An empty selector is allowed, but not empty requirements.
+	if len(terms) == 1 && strings.TrimSpace(terms[0]) == "" {
+		return nil, nil
+	}
+
+	return terms, nil
+}
+
+func parseRequirement(term string) (requirement, error) {
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return requirement{}, fmt.Errorf("empty requirement")
+	}
+
+	if m := setRequirementRe.FindStringSubmatch(term); m != nil {
+		values := strings.Split(m[3], ",")
+		for i := range values {
+			values[i] = strings.TrimSpace(values[i])
+		}
+
+		req := requirement{key: m[1], op: opIn, values: collections.NewSet(values...)}
+		if m[2] == "notin" {
+			req.op = opNotIn
+		}
+		return req, nil
+	}
+
+	var (
+		req   requirement
+		value string
+	)
+	switch {
+	case strings.HasPrefix(term, "!") && !strings.Contains(term, "="):
+		req = requirement{key: strings.TrimSpace(term[1:]), op: opDoesNotExist}
+
+	case strings.Contains(term, "!="):
+		req.key, value, _ = strings.Cut(term, "!=")
+		req.op = opNotEquals
+
+	case strings.Contains(term, "=="):
+		req.key, value, _ = strings.Cut(term, "==")
+		req.op = opEquals
+
+	case strings.Contains(term, "="):
+		req.key, value, _ = strings.Cut(term, "=")
+		req.op = opEquals
+
+	default:
+		req = requirement{key: term, op: opExists}
+	}
+
+	req.key = strings.TrimSpace(req.key)
+	if !keyRe.MatchString(req.key) {
+		return requirement{}, fmt.Errorf("invalid label key %q", req.key)
+	}
+
+	if req.op == opEquals || req.op == opNotEquals {
+		value = strings.TrimSpace(value)
+		if strings.ContainsAny(value, "=!() ") {
+			return requirement{}, fmt.Errorf("invalid label value %q", value)
+		}
+		req.values = collections.NewSet(value)
+	}
+
+	return req, nil
+}
+
+// Empty returns true if the selector has no requirement, i.e. matches any
+// set of labels.
+func (s Selector) Empty() bool {
+	return len(s.requirements) == 0
+}
+
+// Matches returns true if the labels specified satisfy all the requirements
+// of the selector.
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, req := range s.requirements {
+		if !req.matches(labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r requirement) matches(labels map[string]string) bool {
+	value, ok := labels[r.key]
+
+	switch r.op {
+	case opEquals, opIn:
+		return ok && r.values.Contains(value)
+	case opNotEquals, opNotIn:
+		return !ok || !r.values.Contains(value)
+	case opExists:
+		return ok
+	case opDoesNotExist:
+		return !ok
+	}
+
+	return false
+}
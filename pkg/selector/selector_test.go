@@ -0,0 +1,78 @@
+package selector_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/exoscale/cli/pkg/selector"
+)
+
+func TestSelectorMatches(t *testing.T) {
+	labels := map[string]string{
+		"env":  "prod",
+		"team": "web",
+	}
+
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{"", true},
+		{"env=prod", true},
+		{"env==prod", true},
+		{"env=dev", false},
+		{"env!=dev", true},
+		{"env!=prod", false},
+		{"tier!=db", true},
+		{"env in (dev, prod)", true},
+		{"env in (dev,staging)", false},
+		{"env notin (dev,staging)", true},
+		{"tier notin (db)", true},
+		{"tier in (db)", false},
+		{"team", true},
+		{"tier", false},
+		{"!tier", true},
+		{"!team", false},
+		{"env=prod, team in (web,api), !tier", true},
+		{"env=prod,team=api", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			sel, err := selector.Parse(tt.selector)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, sel.Matches(labels))
+		})
+	}
+}
+
+func TestSelectorEmpty(t *testing.T) {
+	sel, err := selector.Parse(" ")
+	require.NoError(t, err)
+	assert.True(t, sel.Empty())
+	assert.True(t, sel.Matches(nil))
+
+	sel, err = selector.Parse("env")
+	require.NoError(t, err)
+	assert.False(t, sel.Empty())
+	assert.False(t, sel.Matches(nil))
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, s := range []string{
+		"env=prod,",
+		",env",
+		"env in (a,b",
+		"env in a,b)",
+		"=prod",
+		"env=a=b",
+		"env x=y",
+	} {
+		t.Run(s, func(t *testing.T) {
+			_, err := selector.Parse(s)
+			assert.Error(t, err)
+		})
+	}
+}
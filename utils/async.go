@@ -0,0 +1,243 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-retryablehttp"
+
+	"github.com/exoscale/cli/pkg/globalstate"
+	"github.com/exoscale/cli/pkg/output"
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+// AsyncOperationOutput represents an operation submitted to the API in
+// --async mode.
+type AsyncOperationOutput struct {
+	ID          v3.UUID           `json:"id"`
+	Zone        v3.ZoneName       `json:"zone"`
+	State       v3.OperationState `json:"state"`
+	ReferenceID v3.UUID           `json:"reference_id,omitempty" outputLabel:"Reference ID"`
+	Command     string            `json:"command,omitempty"`
+}
+
+type asyncOperationsOutput []AsyncOperationOutput
+
+func (o *asyncOperationsOutput) ToJSON()  { output.JSON(o) }
+func (o *asyncOperationsOutput) ToText()  { output.Text(o) }
+func (o *asyncOperationsOutput) ToTable() { output.Table(o) }
+
+// ErrAsyncStopped is returned by the API requests polling an operation
+// submitted in --async mode, once the command has been stopped.
+var ErrAsyncStopped = errors.New("command stopped after submitting operations (--async)")
+
+// asyncState tracks, in --async mode, the operations submitted to the API and
+// the functions decorated with DecorateAsyncOperation(s) currently running or
+// waiting for the completion of one of these operations.
+var asyncState = newAsyncState()
+
+type asyncStateData struct {
+	sync.Mutex
+
+	operations []AsyncOperationOutput
+	running    int
+	waiting    int
+
+	stopOnce sync.Once
+	stopped  chan struct{}
+	cancel   context.CancelFunc
+}
+
+func newAsyncState() *asyncStateData {
+	return &asyncStateData{stopped: make(chan struct{})}
+}
+
+// SetAsyncCancel registers the function canceling the command context, called
+// when the command is stopped in --async mode so that the API calls in
+// progress are aborted.
+func SetAsyncCancel(cancel context.CancelFunc) {
+	asyncState.Lock()
+	asyncState.cancel = cancel
+	asyncState.Unlock()
+}
+
+// AsyncStopped returns true if the command has been stopped after submitting
+// its operations in --async mode.
+func AsyncStopped() bool {
+	select {
+	case <-asyncState.stopped:
+		return true
+	default:
+		return false
+	}
+}
+
+// asyncTransport is an http.RoundTripper recording the operations returned by
+// the API in --async mode, and stopping the command as soon as it starts
+// polling one of them to wait for its completion: such requests fail with
+// ErrAsyncStopped. API responses are passed through unmodified.
+type asyncTransport struct {
+	next http.RoundTripper
+}
+
+func (t *asyncTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet {
+		if dir, id := path.Split(req.URL.Path); strings.HasSuffix(dir, "/operation/") && isAsyncOperation(v3.UUID(id)) {
+			return nil, asyncWaiting(req.Context())
+		}
+		return t.next.RoundTrip(req)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode/100 != 2 {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var op v3.Operation
+	if err := json.Unmarshal(body, &op); err != nil || op.ID == "" || !slices.Contains([]v3.OperationState{
+		v3.OperationStatePending,
+		v3.OperationStateSuccess,
+		v3.OperationStateFailure,
+		v3.OperationStateTimeout,
+	}, op.State) {
+		return resp, nil
+	}
+
+	o := AsyncOperationOutput{ID: op.ID, Zone: endpointZone(req.URL.Hostname()), State: op.State}
+	if op.Reference != nil {
+		o.ReferenceID = op.Reference.ID
+		o.Command = strings.TrimPrefix(op.Reference.Command, "/")
+	}
+
+	asyncState.Lock()
+	asyncState.operations = append(asyncState.operations, o)
+	asyncState.Unlock()
+
+	return resp, nil
+}
+
+// endpointZone returns the zone of a zonal API endpoint host name, e.g.
+// "ch-gva-2" for "api-ch-gva-2.exoscale.com".
+func endpointZone(host string) v3.ZoneName {
+	label, _, _ := strings.Cut(host, ".")
+	_, zone, ok := strings.Cut(label, "-")
+	if !ok {
+		return ""
+	}
+
+	return v3.ZoneName(zone)
+}
+
+func isAsyncOperation(id v3.UUID) bool {
+	asyncState.Lock()
+	defer asyncState.Unlock()
+
+	return slices.ContainsFunc(asyncState.operations, func(o AsyncOperationOutput) bool { return o.ID == id })
+}
+
+// asyncWaiting is called when the command starts waiting for one of its
+// submitted operations. The command is stopped once all the functions
+// decorated with DecorateAsyncOperation(s) are either done or waiting, so
+// that concurrent submissions aren't lost; the caller is blocked until then.
+func asyncWaiting(ctx context.Context) error {
+	asyncState.Lock()
+	asyncState.waiting++
+	stop := asyncState.waiting >= asyncState.running
+	asyncState.Unlock()
+
+	if stop {
+		stopAsync()
+	}
+
+	select {
+	case <-asyncState.stopped:
+		return ErrAsyncStopped
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// asyncStart registers n functions decorated with DecorateAsyncOperation(s)
+// about to run, and returns a function to call when each of them is done.
+func asyncStart(n int) func() {
+	if !globalstate.Async {
+		return func() {}
+	}
+
+	asyncState.Lock()
+	asyncState.running += n
+	asyncState.Unlock()
+
+	return func() {
+		asyncState.Lock()
+		asyncState.running--
+		stop := asyncState.waiting > 0 && asyncState.waiting >= asyncState.running
+		asyncState.Unlock()
+
+		if stop {
+			stopAsync()
+		}
+	}
+}
+
+// stopAsync stops the command without waiting for the completion of the
+// operations it submitted in --async mode: the API requests waiting for them
+// fail, and the command context is canceled so that its remaining steps are
+// skipped.
+func stopAsync() {
+	asyncState.stopOnce.Do(func() {
+		close(asyncState.stopped)
+
+		asyncState.Lock()
+		cancel := asyncState.cancel
+		asyncState.Unlock()
+		if cancel != nil {
+			cancel()
+		}
+	})
+}
+
+// PrintAsyncOperations prints the operations submitted by a command stopped
+// in --async mode, in place of its own output.
+func PrintAsyncOperations() error {
+	asyncState.Lock()
+	out := slices.Clone(asyncOperationsOutput(asyncState.operations))
+	asyncState.Unlock()
+
+	if !globalstate.Quiet {
+		fmt.Fprintln(os.Stderr, "\nStopped after submitting the following operation(s) (--async): "+
+			"the remaining steps of the command, if any, were not performed.")
+	}
+
+	return PrintOutput(&out, nil)
+}
+
+// NewAsyncHTTPClient returns an HTTP client with the same retry logic as the
+// default Exoscale API client, implementing the --async mode.
+func NewAsyncHTTPClient() *http.Client {
+	rc := retryablehttp.NewClient()
+	rc.Logger = log.New(io.Discard, "", 0)
+
+	client := rc.StandardClient()
+	client.Transport = &asyncTransport{next: client.Transport}
+
+	return client
+}
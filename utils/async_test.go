@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/exoscale/cli/pkg/globalstate"
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+func TestEndpointZone(t *testing.T) {
+	assert.Equal(t, v3.ZoneName("ch-gva-2"), endpointZone("api-ch-gva-2.exoscale.com"))
+	assert.Equal(t, v3.ZoneName("de-fra-1"), endpointZone("ppapi-de-fra-1.exoscale.com"))
+	assert.Equal(t, v3.ZoneName(""), endpointZone("127.0.0.1"))
+}
+
+func TestAsyncTransport(t *testing.T) {
+	instanceIDs := []v3.UUID{
+		"22222222-2222-2222-2222-222222222222",
+		"33333333-3333-3333-3333-333333333333",
+	}
+
+	var (
+		mu          sync.Mutex
+		started     []v3.UUID
+		unexpected  []string
+		operationID = func(id v3.UUID) v3.UUID { return "11111111-1111-1111-1111-" + id[24:] }
+	)
+
+	mux := http.NewServeMux()
+	for _, id := range instanceIDs {
+		mux.HandleFunc("/instance/"+id.String()+":start", func(w http.ResponseWriter, _ *http.Request) {
+			mu.Lock()
+			started = append(started, id)
+			mu.Unlock()
+
+			// Response bodies must be passed through unmodified.
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(v3.Operation{
+				ID:        operationID(id),
+				State:     v3.OperationStatePending,
+				Reference: &v3.OperationReference{ID: id, Command: "start-instance"},
+			})
+		})
+	}
+	mux.HandleFunc("/", func(_ http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		unexpected = append(unexpected, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	globalstate.Async, globalstate.Quiet = true, true
+	prevState := asyncState
+	asyncState = newAsyncState()
+	defer func() {
+		globalstate.Async, globalstate.Quiet = false, false
+		asyncState = prevState
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	SetAsyncCancel(cancel)
+
+	client, err := v3.NewClient(
+		credentials.NewStaticCredentials("key", "secret"),
+		v3.ClientOptWithHTTPClient(NewAsyncHTTPClient()),
+	)
+	require.NoError(t, err)
+	client = client.WithEndpoint(v3.Endpoint(server.URL))
+
+	fns := make([]func() error, 0, len(instanceIDs))
+	for _, id := range instanceIDs {
+		fns = append(fns, func() error {
+			op, err := client.StartInstance(ctx, id, v3.StartInstanceRequest{})
+			if err != nil {
+				return err
+			}
+			if _, err = client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+				return err
+			}
+
+			// Follow-up steps must not be performed.
+			_, err = client.RebootInstance(ctx, id)
+			return err
+		})
+	}
+	done := make(chan error, 1)
+	go func() { done <- DecorateAsyncOperations("Starting instances...", fns...) }()
+
+	select {
+	case err := <-done:
+		// The command returns with the errors of the interrupted waits,
+		// running its deferred calls, and its context is canceled.
+		require.Error(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("command not stopped after submitting operations")
+	}
+	assert.True(t, AsyncStopped())
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.ElementsMatch(t, instanceIDs, started)
+	assert.Empty(t, unexpected)
+	require.Len(t, asyncState.operations, len(instanceIDs))
+	for _, op := range asyncState.operations {
+		assert.Equal(t, v3.OperationStatePending, op.State)
+		assert.Equal(t, "start-instance", op.Command)
+	}
+}
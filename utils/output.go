@@ -1,8 +1,11 @@
 package utils
 
 import (
+	"fmt"
 	"os"
+	"reflect"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -24,6 +27,14 @@ func PrintOutput(o output.Outputter, err error) error {
 		return nil
 	}
 
+	if err := output.Sort(o); err != nil {
+		return err
+	}
+
+	if globalstate.Query != "" {
+		return output.PrintQuery(os.Stdout, o, globalstate.Query)
+	}
+
 	if output.GOutputTemplate != "" {
 		o.ToText()
 		return nil
@@ -36,13 +47,51 @@ func PrintOutput(o output.Outputter, err error) error {
 	case "text":
 		o.ToText()
 
+	case "yaml":
+		if y, ok := o.(output.YAMLOutputter); ok {
+			y.ToYAML()
+		} else {
+			output.YAML(o)
+		}
+
+	case "csv":
+		if c, ok := o.(output.CSVOutputter); ok {
+			c.ToCSV()
+		} else {
+			output.CSV(o)
+		}
+
 	default:
-		o.ToTable()
+		// Columns selection and header control are only supported by the
+		// generic table rendering, which is used instead of the command's
+		// own one if requested.
+		if len(globalstate.Columns) > 0 || globalstate.NoHeaders {
+			if !isStructOutput(o) {
+				return fmt.Errorf("--columns and --no-headers are not supported by this command")
+			}
+			output.Table(o)
+		} else {
+			o.ToTable()
+		}
 	}
 
 	return nil
 }
 
+// isStructOutput reports whether an outputter is a struct or a list of
+// structs, i.e. can be rendered by the generic tabular outputs.
+func isStructOutput(o output.Outputter) bool {
+	t := reflect.Indirect(reflect.ValueOf(o)).Type()
+	if t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.Struct
+}
+
 // utils.DecorateAsyncOperation is a cosmetic helper intended for wrapping long
 // asynchronous operations, outputting progress feedback to the user's
 // terminal.
@@ -64,10 +113,12 @@ func DecorateAsyncOperation(message string, fn func()) {
 		mpb.BarOnComplete("✔"),
 	)
 
+	asyncDone := asyncStart(1)
 	done := make(chan struct{})
 	defer close(done)
 	go func(doneCh chan struct{}) {
 		fn()
+		asyncDone()
 		doneCh <- struct{}{}
 	}(done)
 
@@ -97,18 +148,23 @@ func DecorateAsyncOperations(message string, fns ...func() error) error {
 		mpb.BarOnComplete("✔"),
 	)
 
+	var errsMu sync.Mutex
 	errs := &multierror.Error{}
 	done := make(chan struct{})
 	defer close(done)
 
 	for i := 0; i < len(fns); i += 10 {
 		batchSize := min(10, len(fns)-i)
+		asyncDone := asyncStart(batchSize)
 		for j := 0; j < batchSize; j++ {
 			fnIndex := i + j
 			go func(doneCh chan struct{}, fn func() error) {
 				if err := fn(); err != nil {
+					errsMu.Lock()
 					errs = multierror.Append(errs, err)
+					errsMu.Unlock()
 				}
+				asyncDone()
 				doneCh <- struct{}{}
 			}(done, fns[fnIndex])
 		}